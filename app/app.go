@@ -2,7 +2,6 @@ package app
 
 import (
 	"encoding/json"
-	"fmt"
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -33,13 +32,17 @@ import (
 	"github.com/tokenchain/dp-hub/x/bonds"
 	"github.com/tokenchain/dp-hub/x/did"
 	"github.com/tokenchain/dp-hub/x/did/ante"
+	didclient "github.com/tokenchain/dp-hub/x/did/client"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 
 	"github.com/tokenchain/dp-hub/x/nameservice"
 	"github.com/tokenchain/dp-hub/x/oracles"
+	oraclesclient "github.com/tokenchain/dp-hub/x/oracles/client"
 	"github.com/tokenchain/dp-hub/x/payments"
+	"github.com/tokenchain/dp-hub/x/scheduler"
 	"github.com/tokenchain/dp-hub/x/project"
 	"github.com/tokenchain/dp-hub/x/treasury"
+	treasuryclient "github.com/tokenchain/dp-hub/x/treasury/client"
 	"io"
 	"os"
 )
@@ -67,7 +70,9 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distribution.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler, upgradeclient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler, upgradeclient.ProposalHandler, didclient.ProposalHandler, treasuryclient.ProposalHandler,
+			oraclesclient.AddOracleProposalHandler, oraclesclient.UpdateOracleProposalHandler, oraclesclient.RemoveOracleProposalHandler,
+			oraclesclient.SlashOracleProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -81,6 +86,7 @@ var (
 		bonds.AppModuleBasic{},
 		treasury.AppModuleBasic{},
 		oracles.AppModuleBasic{},
+		scheduler.AppModuleBasic{},
 		nameservice.AppModule{},
 	)
 
@@ -95,15 +101,30 @@ var (
 		bonds.BondsMintBurnAccount:       {supply.Minter, supply.Burner},
 		bonds.BatchesIntermediaryAccount: nil,
 		treasury.ModuleName:              {supply.Minter, supply.Burner},
+		treasury.EscrowAccountName:       nil,
 		nameservice.ModuleName:           {supply.Minter, supply.Burner},
 		payments.PayRemainderPool:        nil,
 		payments.ModuleName:              nil,
+		scheduler.ModuleName:             nil,
+		oracles.ModuleName:               {supply.Burner},
 	}
 
 	// Reserved payments module ID prefixes
 	paymentsReservedIdPrefixes = []string{}
 )
 
+func init() {
+	// scheduled txs carry the msgs of the other modules, which the scheduler
+	// codec decodes in the genesis state
+	for name, basic := range ModuleBasics {
+		if name != scheduler.ModuleName {
+			basic.RegisterCodec(scheduler.ModuleCdc)
+		}
+	}
+	exported.RegisterCodec(scheduler.ModuleCdc)
+	scheduler.ModuleCdc.Seal()
+}
+
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 	ModuleBasics.RegisterCodec(cdc)
@@ -138,9 +159,10 @@ type DpApp struct {
 	paymentsKeeper     payments.Keeper
 	projectKeeper      project.Keeper
 	//bonddocKeeper      bonddoc.Keeper
-	bondsKeeper    bonds.Keeper
-	oraclesKeeper  oracles.Keeper
-	treasuryKeeper treasury.Keeper
+	bondsKeeper     bonds.Keeper
+	oraclesKeeper   oracles.Keeper
+	treasuryKeeper  treasury.Keeper
+	schedulerKeeper scheduler.Keeper
 	//nsKeeper           nameservice.Keeper
 
 	/*
@@ -177,7 +199,7 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 
 		did.StoreKey, mint.StoreKey, project.StoreKey, bonds.StoreKey,
 		//bonddoc.StoreKey,
-		treasury.StoreKey, oracles.StoreKey)
+		treasury.StoreKey, oracles.StoreKey, scheduler.StoreKey)
 
 	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -202,6 +224,10 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 	app.subspaces[crisis.ModuleName] = app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[payments.ModuleName] = app.paramsKeeper.Subspace(payments.DefaultParamspace)
 	app.subspaces[project.ModuleName] = app.paramsKeeper.Subspace(project.DefaultParamspace)
+	app.subspaces[did.ModuleName] = app.paramsKeeper.Subspace(did.DefaultParamspace)
+	app.subspaces[scheduler.ModuleName] = app.paramsKeeper.Subspace(scheduler.DefaultParamspace)
+	app.subspaces[treasury.ModuleName] = app.paramsKeeper.Subspace(treasury.DefaultParamspace)
+	app.subspaces[oracles.ModuleName] = app.paramsKeeper.Subspace(oracles.DefaultParamspace)
 
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount)
 	// The BankKeeper allows you perform sdk.Coins interactions
@@ -229,12 +255,19 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
+	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey], app.subspaces[did.ModuleName])
+	app.oraclesKeeper = oracles.NewKeeper(app.cdc, keys[oracles.StoreKey], app.subspaces[oracles.ModuleName], app.supplyKeeper, app.didKeeper)
+	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], app.subspaces[treasury.ModuleName], app.bankKeeper, app.oraclesKeeper, app.supplyKeeper, app.didKeeper)
+
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(did.RouterKey, did.NewTrustedIssuerProposalHandler(app.didKeeper)).
+		AddRoute(treasury.RouterKey, treasury.NewBlacklistProposalHandler(app.treasuryKeeper)).
+		AddRoute(oracles.RouterKey, oracles.NewOracleProposalHandler(app.oraclesKeeper))
 
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
@@ -249,13 +282,12 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 		),
 	)
 
-	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey])
 	app.paymentsKeeper = payments.NewKeeper(app.cdc, keys[payments.StoreKey], app.subspaces[payments.ModuleName], app.bankKeeper, app.didKeeper, paymentsReservedIdPrefixes)
 	app.projectKeeper = project.NewKeeper(app.cdc, keys[project.StoreKey], app.subspaces[project.ModuleName], app.accountKeeper, app.paymentsKeeper, app.didKeeper)
 	//app.bonddocKeeper = bonddoc.NewKeeper(app.cdc, keys[bonddoc.StoreKey])
 	app.bondsKeeper = bonds.NewKeeper(app.bankKeeper, app.supplyKeeper, app.accountKeeper, app.stakingKeeper, app.didKeeper, keys[bonds.StoreKey], app.cdc)
-	app.oraclesKeeper = oracles.NewKeeper(app.cdc, keys[oracles.StoreKey])
-	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], app.bankKeeper, app.oraclesKeeper, app.supplyKeeper, app.didKeeper)
+	// scheduled msgs are dispatched to the handlers of the app router
+	app.schedulerKeeper = scheduler.NewKeeper(app.cdc, keys[scheduler.StoreKey], app.subspaces[scheduler.ModuleName], app.supplyKeeper, app.didKeeper, app.Router())
 	//app.nsKeeper = nameservice.NewKeeper(app.cdc, keys[nameservice.StoreKey], app.bankKeeper)
 
 	app.mm = module.NewManager(
@@ -280,6 +312,7 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 		bonds.NewAppModule(app.bondsKeeper, app.accountKeeper),
 		treasury.NewAppModule(app.treasuryKeeper),
 		oracles.NewAppModule(app.oraclesKeeper),
+		scheduler.NewAppModule(app.schedulerKeeper),
 		//nameservice.NewAppModule(app.nsKeeper, app.bankKeeper),
 	)
 
@@ -294,7 +327,9 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 		crisis.ModuleName,
 		gov.ModuleName,
 		staking.ModuleName,
-		bonds.ModuleName)
+		bonds.ModuleName,
+		scheduler.ModuleName,
+		oracles.ModuleName)
 
 	app.mm.SetOrderInitGenesis(
 		distribution.ModuleName,
@@ -317,7 +352,10 @@ func NewDarkpoolApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLate
 		treasury.ModuleName,
 		// nameservice.ModuleName,
 		oracles.ModuleName,
+		scheduler.ModuleName,
 	)
+	// msg handlers emit the memo tag of their tx
+	app.SetRouter(ante.NewMemoTagRouter(app.Router()))
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 	//app.mm.RegisterInvariants(&app.crisisKeeper)
 
@@ -561,39 +599,40 @@ func initAnteHandler(app *DpApp) sdk.AnteHandler {
 	didPubKeyGetter := did.GetPubKeyGetter(app.didKeeper)
 	projectPubKeyGetter := project.GetPubKeyGetter(app.projectKeeper, app.didKeeper)
 
-	defaultDxpAnteHandler := ante.DefaultAnteHandler(app.accountKeeper, app.bankKeeper, app.supplyKeeper, didPubKeyGetter)
-	didAnteHandler := ante.DidAnteHandler(app.accountKeeper, app.bankKeeper, app.supplyKeeper, didPubKeyGetter)
-	projectAnteHandler := ante.DefaultAnteHandler(app.accountKeeper, app.bankKeeper, app.supplyKeeper, projectPubKeyGetter)
+	// Each module registers the signer resolver of its msgs once, so that an
+	// IxoTx can batch msgs of several modules
+	signerRouter := ante.NewSignerRouter().
+		AddRoute(did.RouterKey, didPubKeyGetter).
+		AddRoute(project.RouterKey, projectPubKeyGetter).
+		AddRoute(bonds.RouterKey, didPubKeyGetter).
+		AddRoute(treasury.RouterKey, didPubKeyGetter).
+		AddRoute(payments.RouterKey, didPubKeyGetter).
+		AddRoute(scheduler.RouterKey, didPubKeyGetter).
+		AddRoute(oracles.RouterKey, didPubKeyGetter)
+
+	// project creation funds the project and is verified on its own
+	signerRouter.AddAnteHandler(project.RouterKey, project.TypeMsgCreateProject,
+		project.NewProjectCreationAnteHandler(
+			app.accountKeeper, app.supplyKeeper, app.bankKeeper,
+			app.didKeeper, projectPubKeyGetter))
+
+	ixoTxAnteHandler := ante.DefaultAnteHandler(app.accountKeeper, app.bankKeeper, app.supplyKeeper, app.didKeeper, signerRouter.PubKeyGetter())
 	cosmosAnteHandler := auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	routerAnteHandler := ante.NewRouterAnteHandler(signerRouter, ixoTxAnteHandler, cosmosAnteHandler)
 
-	projectCreationAnteHandler := project.NewProjectCreationAnteHandler(
-		app.accountKeeper, app.supplyKeeper, app.bankKeeper,
-		app.didKeeper, projectPubKeyGetter)
+	// Credential requirements and memo tags apply to every tx regardless of module
+	credentialAnteHandler := sdk.ChainAnteDecorators(ante.NewCredentialRequirementDecorator(app.didKeeper))
+	memoTagAnteHandler := sdk.ChainAnteDecorators(ante.NewMemoTagDecorator())
 
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (_ sdk.Context, _ error) {
-		msg := tx.GetMsgs()[0]
-		switch msg.Route() {
-		case did.RouterKey:
-			fmt.Println("darkpool did tx handler")
-			return didAnteHandler(ctx, tx, simulate)
-		case project.RouterKey:
-			switch msg.Type() {
-			case project.TypeMsgCreateProject:
-				return projectCreationAnteHandler(ctx, tx, simulate)
-			default:
-				return projectAnteHandler(ctx, tx, simulate)
-			}
-		case bonds.RouterKey:
-			fmt.Println("node bonds tx handler")
-			return defaultDxpAnteHandler(ctx, tx, simulate)
-		case treasury.RouterKey:
-			return defaultDxpAnteHandler(ctx, tx, simulate)
-		case payments.RouterKey:
-			return defaultDxpAnteHandler(ctx, tx, simulate)
-		default:
-			fmt.Println("node cosmos tx handler")
-			return cosmosAnteHandler(ctx, tx, simulate)
+		if newCtx, err := credentialAnteHandler(ctx, tx, simulate); err != nil {
+			return newCtx, err
+		}
+		ctx, err := memoTagAnteHandler(ctx, tx, simulate)
+		if err != nil {
+			return ctx, err
 		}
+		return routerAnteHandler(ctx, tx, simulate)
 	}
 }
 
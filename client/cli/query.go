@@ -2,14 +2,25 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/tokenchain/dp-hub/client/utils"
 )
 
+const (
+	FlagEvents  = "events"
+	FlagMemoTag = "memo-tag"
+
+	eventFormat = "{eventType}.{eventAttribute}={value}"
+)
+
 func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 
@@ -39,3 +50,78 @@ func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func QueryTxsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs",
+		Short: "Query for paginated transactions by memo tag or by a set of events",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Search for transactions whose memo is tagged with the memo tag, or that match
+the exact given events, or both. A memo is tagged by starting it with tag:{tag}.
+
+Example:
+$ dpcli query txs --%s INV-2020-0042
+$ dpcli query txs --%s 'message.sender=dx0...&message.action=send' --page 1 --limit 30
+`, FlagMemoTag, FlagEvents)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			events, err := parseEvents(viper.GetString(FlagEvents))
+			if err != nil {
+				return err
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var txs interface{}
+			if tag := viper.GetString(FlagMemoTag); tag != "" {
+				txs, err = utils.QueryTxsByMemoTag(cliCtx, tag, events, page, limit)
+			} else {
+				txs, err = utils.QueryTxsByEvents(cliCtx, events, page, limit)
+			}
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(txs)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	_ = viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	_ = viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+	cmd.Flags().String(FlagMemoTag, "", "Memo tag of the transactions")
+	_ = viper.BindPFlag(FlagMemoTag, cmd.Flags().Lookup(FlagMemoTag))
+	cmd.Flags().String(FlagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
+	_ = viper.BindPFlag(FlagEvents, cmd.Flags().Lookup(FlagEvents))
+	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	_ = viper.BindPFlag(flags.FlagPage, cmd.Flags().Lookup(flags.FlagPage))
+	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
+	_ = viper.BindPFlag(flags.FlagLimit, cmd.Flags().Lookup(flags.FlagLimit))
+
+	return cmd
+}
+
+// parseEvents parses '&' separated events of the form {eventType}.{eventAttribute}={value}
+// into tx index queries
+func parseEvents(eventsStr string) ([]string, error) {
+	eventsStr = strings.Trim(eventsStr, "'")
+	if eventsStr == "" {
+		return nil, nil
+	}
+
+	var tmEvents []string
+	for _, event := range strings.Split(eventsStr, "&") {
+		tokens := strings.Split(event, "=")
+		if len(tokens) != 2 {
+			return nil, fmt.Errorf("invalid event; event %s should be of the format: %s", event, eventFormat)
+		}
+		if tokens[0] == tmtypes.TxHeightKey {
+			tmEvents = append(tmEvents, fmt.Sprintf("%s=%s", tokens[0], tokens[1]))
+		} else {
+			tmEvents = append(tmEvents, fmt.Sprintf("%s='%s'", tokens[0], tokens[1]))
+		}
+	}
+	return tmEvents, nil
+}
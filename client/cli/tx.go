@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const FlagHex = "hex"
+
+// GetDidSignCommand signs an unsigned tx offline with a DID document. It is
+// the counterpart of the /txs/sign_data route for keys that never go online.
+func GetDidSignCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-sign [unsigned-tx-file] [did-doc-file]",
+		Short: "Sign an unsigned tx offline with a did document",
+		Long: `Sign an unsigned tx offline with the did document of its signer, or of a controller
+of the signer, and print the signed tx. The unsigned tx is the sign message printed by a
+tx command with --generate-only; it carries the account number and sequence, so signing
+does not connect to a node. With --hex the tx is printed hex encoded, as expected by
+the POST /txs route.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var signMsg auth.StdSignMsg
+			if err := cdc.UnmarshalJSON(bz, &signMsg); err != nil {
+				return err
+			}
+
+			didDoc, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			ixoDid, err := exported.UnmarshalDxpDid(string(didDoc))
+			if err != nil {
+				return err
+			}
+
+			tx, err := ante.SignStdSignMsg(ixoDid, signMsg, viper.GetString(ante.FlagFeeGranter))
+			if err != nil {
+				return err
+			}
+
+			var output []byte
+			if viper.GetBool(FlagHex) {
+				txBytes, err := cdc.MarshalJSON(tx)
+				if err != nil {
+					return err
+				}
+				output = []byte(hex.EncodeToString(txBytes))
+			} else if viper.GetBool(flags.FlagIndentResponse) {
+				output, err = cdc.MarshalJSONIndent(tx, "", "  ")
+			} else {
+				output, err = cdc.MarshalJSON(tx)
+			}
+			if err != nil {
+				return err
+			}
+
+			outputDoc := viper.GetString(flags.FlagOutputDocument)
+			if outputDoc == "" {
+				fmt.Printf("%s\n", output)
+				return nil
+			}
+			return ioutil.WriteFile(outputDoc, append(output, '\n'), 0644)
+		},
+	}
+
+	cmd.Flags().Bool(FlagHex, false, "Print the signed tx hex encoded")
+	cmd.Flags().String(ante.FlagFeeGranter, "", "Did whose fee allowance pays the fee")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+// GetDidBroadcastCommand broadcasts a tx signed with did-sign or
+// combine-signatures. It is the counterpart of the POST /txs route.
+func GetDidBroadcastCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-broadcast [signed-tx-file]",
+		Short: "Broadcast a tx signed offline with a did document",
+		Long: `Broadcast a tx signed offline with did-sign or combine-signatures. The file holds the
+signed tx as JSON or hex encoded.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			tx, err := parseSignedTx(cdc, bz)
+			if err != nil {
+				return err
+			}
+			if err := tx.ValidateBasic(); err != nil {
+				return err
+			}
+
+			res, err := ante.BroadcastIxoTx(cliCtx, tx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func parseSignedTx(cdc *codec.Codec, bz []byte) (tx ante.IxoTx, err error) {
+	str := strings.TrimSpace(string(bz))
+	if !strings.HasPrefix(str, "{") {
+		bz, err = hex.DecodeString(strings.TrimPrefix(str, "0x"))
+		if err != nil {
+			return tx, fmt.Errorf("signed tx is neither JSON nor hex encoded: %s", err.Error())
+		}
+	}
+	err = cdc.UnmarshalJSON(bz, &tx)
+	return tx, err
+}
@@ -26,6 +26,11 @@ type (
 		PubKey string `json:"pub_key" yaml:"pub_key"`
 	}
 
+	EstimateFeeReq struct {
+		Msg  string `json:"msg" yaml:"msg"`
+		Memo string `json:"memo" yaml:"memo"`
+	}
+
 	SignDataResponse struct {
 		SignBytes string      `json:"sign_bytes" yaml:"sign_bytes"`
 		Fee       auth.StdFee `json:"fee" yaml:"fee"`
@@ -104,7 +109,7 @@ func QueryTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		searchResult, err := utils.QueryTxsByEvents(cliCtx, events, page, limit)
+		searchResult, err := utils2.QueryTxsByEvents(cliCtx, events, page, limit)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -208,6 +213,14 @@ func SignDataRequest(cliCtx context.CLIContext) http.HandlerFunc {
 				return
 			}
 
+			// The sequence of the signer did is the nonce of the tx
+			sequence, err := ante.QueryDidSequence(cliCtx, ixoMsg.GetSignerDid(), txBldr.Sequence())
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			txBldr = txBldr.WithSequence(sequence)
+
 			// Build the transaction
 			stdSignMsg, err = txBldr.BuildSignMsg(msgs)
 			if err != nil {
@@ -216,12 +229,10 @@ func SignDataRequest(cliCtx context.CLIContext) http.HandlerFunc {
 			}
 
 			// Create dummy tx with blank signature for fee approximation
-			signature := auth.StdSignature{}
-			tx := auth.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee,
-				[]auth.StdSignature{signature}, stdSignMsg.Memo)
+			tx := ante.NewIxoTxSingleMsg(ixoMsg, stdSignMsg.Fee, ante.IxoSignature{}, stdSignMsg.Memo)
 
 			// Approximate fee
-			fee, err := dap.ApproximateFeeForTx(cliCtx, tx, txBldr.ChainID())
+			fee, err := dap.ApproximateFeeForTxDap(cliCtx, tx)
 			if err != nil {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 				return
@@ -238,3 +249,52 @@ func SignDataRequest(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponseBare(w, cliCtx, output)
 	}
 }
+
+// EstimateFeeRequest simulates a tx made up of the hex encoded msg and
+// responds with the estimated gas and the fee for it
+func EstimateFeeRequest(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EstimateFeeReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msgBytes, err := hex.DecodeString(strings.TrimPrefix(req.Msg, "0x"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var msg sdk.Msg
+		err = cliCtx.Codec.UnmarshalJSON(msgBytes, &msg)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// all messages must be of type ixo.IxoMsg
+		ixoMsg, ok := msg.(ante.IxoMsg)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, exported.IntErr("msg must be ixo.IxoMsg").Error())
+			return
+		}
+
+		tx := ante.NewIxoTxSingleMsg(ixoMsg, auth.StdFee{}, ante.IxoSignature{}, req.Memo)
+		fee, err := dap.ApproximateFeeForTxDap(cliCtx, tx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, fee)
+	}
+}
@@ -0,0 +1,69 @@
+package tx
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/tokenchain/dp-hub/x/dap/types"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// simulateClient answers tx simulations with a fixed gas
+type simulateClient struct {
+	rpcclient.Client
+	cdc *codec.Codec
+	gas uint64
+}
+
+func (c simulateClient) ABCIQueryWithOptions(path string, _ tmbytes.HexBytes,
+	_ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	if path != "/app/simulate" {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "unknown path " + path}}, nil
+	}
+	simRes := sdk.SimulationResponse{GasInfo: sdk.GasInfo{GasUsed: c.gas}}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: c.cdc.MustMarshalBinaryBare(simRes)}}, nil
+}
+
+func TestEstimateFeeRequest(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithTrustNode(true).
+		WithClient(simulateClient{cdc: cdc, gas: 1000})
+	handler := EstimateFeeRequest(cliCtx)
+
+	estimate := func(msg string) *httptest.ResponseRecorder {
+		body := cdc.MustMarshalJSON(EstimateFeeReq{Msg: msg})
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest("POST", "/txs/estimate_fee", bytes.NewReader(body)))
+		return w
+	}
+
+	// the simulated gas is adjusted, and the fee is for the adjusted gas
+	id := exported.NewDidGeneratorBuilder().Build()
+	var msg sdk.Msg = did.NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType)
+	w := estimate("0x" + hex.EncodeToString(cdc.MustMarshalJSON(msg)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var fee auth.StdFee
+	require.Nil(t, cdc.UnmarshalJSON(w.Body.Bytes(), &fee))
+	require.Equal(t, uint64(1500), fee.Gas)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.NativeToken, 38)), fee.Amount)
+
+	// a msg that is not hex encoded, or not a msg, is rejected
+	require.Equal(t, http.StatusBadRequest, estimate("msg").Code)
+	require.Equal(t, http.StatusBadRequest, estimate(hex.EncodeToString([]byte("{}"))).Code)
+}
@@ -13,4 +13,5 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs/encode", auRest.EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/decode", auRest.DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/sign_data", SignDataRequest(cliCtx)).Methods("POST")
+	r.HandleFunc("/txs/estimate_fee", EstimateFeeRequest(cliCtx)).Methods("POST")
 }
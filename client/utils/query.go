@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,6 +13,7 @@ import (
 	core "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tokenchain/dp-hub/x/did/ante"
 	"io"
+	"strings"
 	"time"
 )
 
@@ -38,6 +40,18 @@ func formatTxResult(cdc *codec.Codec, resTx *core.ResultTx, resBlock *core.Resul
 	return sdk.NewResponseResultTx(resTx, tx, resBlock.Block.Time.Format(time.RFC3339)), nil
 }
 
+func formatTxResults(cdc *codec.Codec, resTxs []*core.ResultTx, resBlocks map[int64]*core.ResultBlock) ([]sdk.TxResponse, error) {
+	out := make([]sdk.TxResponse, len(resTxs))
+	for i := range resTxs {
+		txResponse, err := formatTxResult(cdc, resTxs[i], resBlocks[resTxs[i].Height])
+		if err != nil {
+			return nil, err
+		}
+		out[i] = txResponse
+	}
+	return out, nil
+}
+
 func getBlocksForTxResults(cliCtx context.CLIContext, resTxs []*core.ResultTx) (map[int64]*core.ResultBlock, error) {
 	node, err := cliCtx.GetNode()
 	if err != nil {
@@ -109,3 +123,58 @@ func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, erro
 
 	return out, nil
 }
+
+// QueryTxsByEvents searches the tx index for the txs that match all the
+// events. Unlike the auth utils it decodes IxoTxs as well as StdTxs.
+func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit int) (*sdk.SearchTxsResult, error) {
+	if len(events) == 0 {
+		return nil, errors.New("must declare at least one event to search")
+	}
+	if page <= 0 {
+		return nil, errors.New("page must greater than 0")
+	}
+	if limit <= 0 {
+		return nil, errors.New("limit must greater than 0")
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	prove := !cliCtx.TrustNode
+	resTxs, err := node.TxSearch(strings.Join(events, " AND "), prove, page, limit, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if prove {
+		for _, resTx := range resTxs.Txs {
+			if err := ValidateTxResult(cliCtx, resTx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resBlocks, err := getBlocksForTxResults(cliCtx, resTxs.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := formatTxResults(cliCtx.Codec, resTxs.Txs, resBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := sdk.NewSearchTxsResult(resTxs.TotalCount, len(txs), page, limit, txs)
+	return &result, nil
+}
+
+// QueryTxsByMemoTag searches the tx index for the txs whose memo is tagged
+// with the tag, and that match all the other events
+func QueryTxsByMemoTag(cliCtx context.CLIContext, tag string, events []string, page, limit int) (*sdk.SearchTxsResult, error) {
+	if err := ante.ValidateMemoTag(tag); err != nil {
+		return nil, err
+	}
+	return QueryTxsByEvents(cliCtx, append(events, ante.MemoTagEvent(tag)), page, limit)
+}
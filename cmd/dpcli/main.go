@@ -83,7 +83,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
-		authCli.QueryTxsByEventsCmd(cdc),
+		cli2.QueryTxsCmd(cdc),
 		flags.LineBreak,
 		cli2.QueryTxCmd(cdc),
 	)
@@ -109,6 +109,9 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		authCli.GetBroadcastCommand(cdc),
 		authCli.GetEncodeCommand(cdc),
 		flags.LineBreak,
+		cli2.GetDidSignCommand(cdc),
+		cli2.GetDidBroadcastCommand(cdc),
+		flags.LineBreak,
 	)
 	app.ModuleBasics.AddTxCommands(txCmd, cdc)
 	return txCmd
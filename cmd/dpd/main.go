@@ -20,6 +20,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	didCli "github.com/tokenchain/dp-hub/x/did/client/cli"
 	oraclesCli "github.com/tokenchain/dp-hub/x/oracles/client/cli"
 
 	"github.com/tokenchain/dp-hub/app"
@@ -59,6 +60,7 @@ func main() {
 		genUtilCli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics),
 		AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 		oraclesCli.AddGenesisOracleCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
+		didCli.AddGenesisIssuerCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 		genUtilCli.MigrateGenesisCmd(ctx, cdc),
 	)
 
@@ -13,6 +13,7 @@ var (
 	// Auth
 
 	ApproximateFeeForTx     = auth.ApproximateFeeForTx
+	ApproximateFeeForTxDap  = auth.ApproximateFeeForTxDap
 	GenerateOrBroadcastMsgs = auth.GenerateOrBroadcastMsgs
 	//CompleteAndBroadcastTxRest       = auth.CompleteAndBroadcastTxRest
 	SignAndBroadcastTxFromStdSignMsg = auth.SignAndBroadcastTxFromStdSignMsg
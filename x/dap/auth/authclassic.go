@@ -33,10 +33,6 @@ const (
 )
 
 var (
-	expectedMinGasPrices       = "0.025" + types.NativeToken
-	approximationGasAdjustment = float64(1.5)
-	// TODO: parameterise (or remove) hard-coded gas prices and adjustments
-
 	// simulation signature values used to estimate gas consumption
 	simEd25519Pubkey   ed25519tm.PubKeyEd25519
 	simEd25519Sig      [Ed25519SignatureLen]byte
@@ -86,6 +82,9 @@ func consumeSimSigGas(gasmeter sdk.GasMeter, pubkey crypto.PubKey, sig ante.IxoS
 	simSig := ante.IxoSignature{}
 	if binary.Size(sig.SignatureValue) == 0 {
 		simSig.SignatureValue = simEd25519Sig[:]
+		if _, ok := pubkey.(secp256k1.PubKeySecp256k1); ok {
+			simSig.SignatureValue = simSecp256k1Sig[:]
+		}
 	}
 	simSig.Created = simSig.Created.Add(1) // maximizes signature length
 	ModuleCdc := codec.New()
@@ -123,7 +122,11 @@ func ProcessSig(ctx sdk.Context, acc sdkexported.Account, sig ante.IxoSignature,
 	}
 
 	// Consume signature gas
-	ctx.GasMeter().ConsumeGas(p.SigVerifyCostED25519, "ante verify: ed25519")
+	if _, ok := pubKey.(secp256k1.PubKeySecp256k1); ok {
+		ctx.GasMeter().ConsumeGas(p.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+	} else {
+		ctx.GasMeter().ConsumeGas(p.SigVerifyCostED25519, "ante verify: ed25519")
+	}
 	// Verify signature
 	if !simulate && !pubKey.VerifyBytes(signBytes, sig.SignatureValue[:]) {
 		return nil, ante.Unauthorized("Signature Verification failed. dxp")
@@ -280,8 +283,23 @@ func signAndBroadcast(ctx context.CLIContext, msg auth.StdSignMsg, ixoDid export
 	if len(msg.Msgs) != 1 {
 		panic("expected one message")
 	}
-	privKey := exported.RecoverDidEd25519ToPrivateKey(ixoDid)
-	signature := SignIxoMessageEd25519(msg.Bytes(), privKey)
+	ixoMsg, isIxoMsg := msg.Msgs[0].(ante.IxoMsg)
+
+	// the sequence of the signer did is the nonce of the tx
+	if isIxoMsg {
+		sequence, err := ante.QueryDidSequence(ctx, ixoMsg.GetSignerDid(), msg.Sequence)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+		msg.Sequence = sequence
+	}
+
+	signature := ante.SignMsgForSignature(ixoDid, msg)
+
+	// signing with a DID other than the message signer is done as its controller
+	if isIxoMsg && ixoMsg.GetSignerDid() != ixoDid.Did {
+		signature.Controller = ixoDid.Did
+	}
 
 	tx := ante.NewIxoTxSingleMsg(msg.Msgs[0], msg.Fee, signature, msg.Memo)
 	fmt.Println(msg)
@@ -296,28 +314,16 @@ func signAndBroadcast(ctx context.CLIContext, msg auth.StdSignMsg, ixoDid export
 	return res, nil
 }
 
-func ApproximateFeeForTxDap(cliCtx context.CLIContext, tx ante.IxoTx, chainId string) (auth.StdFee, error) {
-
-	// Set up a transaction builder
-	cdc := cliCtx.Codec
-	txEncoder := auth.DefaultTxEncoder
-	gasAdjustment := approximationGasAdjustment
-	fees := sdk.NewCoins(sdk.NewCoin(types.NativeToken, sdk.OneInt()))
-	txBldr := auth.NewTxBuilder(txEncoder(cdc), 0, 0, 0, gasAdjustment, true, chainId, tx.Memo, fees, nil)
-
-	// Approximate gas consumption
-	txBldr, err := utils.EnrichWithGas(txBldr, cliCtx, tx.Msgs)
+// ApproximateFeeForTxDap simulates the tx and returns the simulated gas times
+// the configured gas adjustment, and the fee for that gas at the configured
+// gas prices
+func ApproximateFeeForTxDap(cliCtx context.CLIContext, tx ante.IxoTx) (auth.StdFee, error) {
+	_, gas, err := ante.SimulateIxoTx(cliCtx, tx, EstimateGasAdjustment())
 	if err != nil {
 		return auth.StdFee{}, err
 	}
 
-	// Clear fees and set gas-prices to deduce updated fee = (gas * gas-prices)
-	signMsg, err := txBldr.WithFees("").WithGasPrices(expectedMinGasPrices).BuildSignMsg(tx.Msgs)
-	if err != nil {
-		return auth.StdFee{}, err
-	}
-
-	return signMsg.Fee, nil
+	return feeForGas(gas)
 }
 
 func ApproximateFeeForTx(cliCtx context.CLIContext, tx auth.StdTx, chainId string) (auth.StdFee, error) {
@@ -325,7 +331,7 @@ func ApproximateFeeForTx(cliCtx context.CLIContext, tx auth.StdTx, chainId strin
 	// Set up a transaction builder
 	cdc := cliCtx.Codec
 	txEncoder := auth.DefaultTxEncoder
-	gasAdjustment := approximationGasAdjustment
+	gasAdjustment := EstimateGasAdjustment()
 	fees := sdk.NewCoins(sdk.NewCoin(types.NativeToken, sdk.OneInt()))
 	txBldr := auth.NewTxBuilder(txEncoder(cdc), 0, 0, 0, gasAdjustment, true, chainId, tx.Memo, fees, nil)
 
@@ -335,13 +341,26 @@ func ApproximateFeeForTx(cliCtx context.CLIContext, tx auth.StdTx, chainId strin
 		return auth.StdFee{}, err
 	}
 
-	// Clear fees and set gas-prices to deduce updated fee = (gas * gas-prices)
-	signMsg, err := txBldr.WithFees("").WithGasPrices(expectedMinGasPrices).BuildSignMsg(tx.Msgs)
+	return feeForGas(txBldr.Gas())
+}
+
+// feeForGas returns the fee = (gas * gas-prices) at the configured gas prices,
+// leaving out the denoms that come to nothing
+func feeForGas(gas uint64) (auth.StdFee, error) {
+	gasPrices, err := EstimateGasPrices()
 	if err != nil {
 		return auth.StdFee{}, err
 	}
 
-	return signMsg.Fee, nil
+	gasDec := sdk.NewDec(int64(gas))
+	fees := sdk.NewCoins()
+	for _, gp := range gasPrices {
+		if fee := sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasDec).Ceil().RoundInt()); fee.IsPositive() {
+			fees = append(fees, fee)
+		}
+	}
+
+	return auth.NewStdFee(gas, fees), nil
 }
 
 func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, msg sdk.Msg, ixoDid exported.IxoDid) error {
@@ -361,8 +380,7 @@ func CompleteAndBroadcastTxRest(cliCtx context.CLIContext, msg sdk.Msg, ixoDid e
 
 	// Construct dummy tx and approximate and set fee
 	tx := ante.NewIxoTxSingleMsg(msg, auth.StdFee{}, ante.IxoSignature{}, "")
-	chainId := viper.GetString(flags.FlagChainID)
-	fee, err := ApproximateFeeForTxDap(cliCtx, tx, chainId)
+	fee, err := ApproximateFeeForTxDap(cliCtx, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -396,12 +414,14 @@ func SignIxoMessageEd25519(signBytes []byte, privKey [ed25519.PrivateKeySize]byt
 	return ante.NewSignature(time.Now(), signatureBytes[:])
 }
 
-/*
-func SignIxoMessageSecp256k1(signBytes []byte, privKey [32]byte) IxoSignature {
-	signatureBytes := ed25519.Sign(&privKey, signBytes)
-	return NewSignature(time.Now(), *signatureBytes)
+func SignIxoMessageSecp256k1(signBytes []byte, privKey secp256k1.PrivKeySecp256k1) (ante.IxoSignature, error) {
+	signatureBytes, err := privKey.Sign(signBytes)
+	if err != nil {
+		return ante.IxoSignature{}, err
+	}
+	return ante.NewSignature(time.Now(), signatureBytes), nil
 }
-*/
+
 func SignAndBroadcastTxCli(cliCtx context.CLIContext, msg sdk.Msg, sovrinDid exported.IxoDid) error {
 
 	bldr := auth.NewTxBuilderFromCLI(cliCtx.Input).
@@ -416,14 +436,14 @@ func SignAndBroadcastTxCli(cliCtx context.CLIContext, msg sdk.Msg, sovrinDid exp
 	msgs := []sdk.Msg{msg}
 
 	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
-		var err error // important so that enrichWithGas overwrites txBldr
-		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		tx := ante.NewIxoTxSingleMsg(msg, auth.StdFee{}, ante.IxoSignature{}, txBldr.Memo())
+		fee, err := ApproximateFeeForTxDap(cliCtx, tx)
 		if err != nil {
 			return err
 		}
+		txBldr = txBldr.WithGas(fee.Gas)
 
-		gasEst := utils.GasEstimateResponse{GasEstimate: txBldr.Gas()}
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", cliCtx.Codec.MustMarshalJSON(fee))
 	}
 
 	if cliCtx.Simulate {
@@ -477,8 +497,7 @@ func SignAndBroadcastTxRest(cliCtx context.CLIContext, msg sdk.Msg, sovrinDid ex
 	// TODO: implement using txBldr or just remove function completely (ref: #123)
 	// Construct dummy tx and approximate and set fee
 	tx := ante.NewIxoTxSingleMsg(msg, auth.StdFee{}, ante.IxoSignature{}, "")
-	chainId := viper.GetString(flags.FlagChainID)
-	fee, err := ApproximateFeeForTxDap(cliCtx, tx, chainId)
+	fee, err := ApproximateFeeForTxDap(cliCtx, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/tokenchain/dp-hub/x/dap/types"
+)
+
+// Gas prices and adjustment used to estimate the fee of txs signed by a did.
+// They are read from config.toml or from the DXO_ESTIMATE_GAS_PRICES and
+// DXO_ESTIMATE_GAS_ADJUSTMENT environment variables.
+const (
+	ConfigEstimateGasPrices     = "estimate-gas-prices"
+	ConfigEstimateGasAdjustment = "estimate-gas-adjustment"
+
+	DefaultEstimateGasPrices     = "0.025" + types.NativeToken
+	DefaultEstimateGasAdjustment = 1.5
+)
+
+// EstimateGasPrices returns the configured gas prices for fee estimation
+func EstimateGasPrices() (sdk.DecCoins, error) {
+	gasPrices := viper.GetString(ConfigEstimateGasPrices)
+	if gasPrices == "" {
+		gasPrices = DefaultEstimateGasPrices
+	}
+	return sdk.ParseDecCoins(gasPrices)
+}
+
+// EstimateGasAdjustment returns the configured factor that simulated gas is
+// multiplied by for fee estimation
+func EstimateGasAdjustment() float64 {
+	if adjustment := viper.GetFloat64(ConfigEstimateGasAdjustment); adjustment > 0 {
+		return adjustment
+	}
+	return DefaultEstimateGasAdjustment
+}
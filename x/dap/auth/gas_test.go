@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenchain/dp-hub/x/dap/types"
+)
+
+func TestFeeForGas(t *testing.T) {
+	defer viper.Set(ConfigEstimateGasPrices, "")
+
+	// the default gas prices
+	fee, err := feeForGas(1000)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1000), fee.Gas)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.NativeToken, 25)), fee.Amount)
+
+	// the fee of each denom is rounded up
+	viper.Set(ConfigEstimateGasPrices, "0.01"+types.NativeToken+",0.5stake")
+	fee, err = feeForGas(101)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.NativeToken, 2), sdk.NewInt64Coin("stake", 51)), fee.Amount)
+
+	// denoms that come to nothing are left out
+	fee, err = feeForGas(0)
+	require.Nil(t, err)
+	require.True(t, fee.Amount.IsValid())
+	require.True(t, fee.Amount.Empty())
+
+	viper.Set(ConfigEstimateGasPrices, "0stake")
+	_, err = feeForGas(1000)
+	require.NotNil(t, err)
+}
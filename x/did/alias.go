@@ -12,6 +12,8 @@ const (
 	QuerierRoute     = types.QuerierRoute
 	RouterKey        = types.RouterKey
 	StoreKey         = types.StoreKey
+
+	DefaultParamspace = types.DefaultParamspace
 	DefaultCodespace = types.ModuleName
 )
 
@@ -22,8 +24,21 @@ type (
 	DidDoc       = exported.DidDoc
 	IxoDid       = exported.IxoDid*/
 
-	MsgAddDid        = types.MsgAddDid
-	MsgAddCredential = types.MsgAddCredential
+	MsgAddDid             = types.MsgAddDid
+	MsgAddCredential      = types.MsgAddCredential
+	MsgAddController      = types.MsgAddController
+	MsgRemoveController   = types.MsgRemoveController
+	MsgSetKeySet          = types.MsgSetKeySet
+	MsgGrantFeeAllowance  = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance = types.MsgRevokeFeeAllowance
+
+	FeeAllowance  = types.FeeAllowance
+	FeeAllowances = types.FeeAllowances
+
+	TrustedIssuer         = types.TrustedIssuer
+	TrustedIssuers        = types.TrustedIssuers
+	TrustedIssuerProposal = types.TrustedIssuerProposal
+	Params                = types.Params
 
 /*	IxoTx        = ante.IxoTx
 	IxoSignature = ante.IxoSignature
@@ -54,6 +69,18 @@ var (
 	ValidateGenesis     = types.ValidateGenesis
 	UnmarshalIxoDid     = types.UnmarshalIxoDid
 
+	NewTrustedIssuer         = types.NewTrustedIssuer
+	NewTrustedIssuerProposal = types.NewTrustedIssuerProposal
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	NewMsgAddDid             = types.NewMsgAddDid
+	NewMsgAddController      = types.NewMsgAddController
+	NewMsgRemoveController   = types.NewMsgRemoveController
+	NewMsgSetKeySet          = types.NewMsgSetKeySet
+	NewFeeAllowance          = types.NewFeeAllowance
+	NewMsgGrantFeeAllowance  = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance = types.NewMsgRevokeFeeAllowance
+
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 
@@ -8,8 +8,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
+// DidAnteKeeper is the did state that DID-signed txs are checked against
+type DidAnteKeeper interface {
+	DidSequenceKeeper
+	FeeAllowanceKeeper
+}
+
 //use in bond
-func DefaultAnteHandler(ak auth.AccountKeeper, bk bank.Keeper, sk supply.Keeper, pubKeyGetter PubKeyGetter) sdk.AnteHandler {
+func DefaultAnteHandler(ak auth.AccountKeeper, bk bank.Keeper, sk supply.Keeper, dk DidAnteKeeper, pubKeyGetter PubKeyGetter) sdk.AnteHandler {
 	//return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -19,23 +25,22 @@ func DefaultAnteHandler(ak auth.AccountKeeper, bk bank.Keeper, sk supply.Keeper,
 		NewDapConsumeGasForTxSizeDecorator(ak, pubKeyGetter),
 		NewDapPubKeyDecorator(ak, pubKeyGetter), // SetPubKeyDecorator must be called before all signature verification decorators
 		//ante.NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, sk, pubKeyGetter),
+		NewDeductFeeDecorator(ak, sk, dk, pubKeyGetter),
 		NewConsumeVerSignGasDecorator(ak, pubKeyGetter),
-		NewSigVerificationAndIncrementSequenceDecorator(ak, pubKeyGetter),
+		NewSigVerificationAndIncrementSequenceDecorator(ak, dk, pubKeyGetter),
 	)
 }
 
-func DidAnteHandler(ak auth.AccountKeeper, bk bank.Keeper, sk supply.Keeper, pubKeyGetter PubKeyGetter) sdk.AnteHandler {
+func DidAnteHandler(ak auth.AccountKeeper, bk bank.Keeper, sk supply.Keeper, dk DidAnteKeeper, pubKeyGetter PubKeyGetter) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewValidateMemoDecorator(ak),
-		NewOnlyOneMsgDecorator(),
 		NewDapConsumeGasForTxSizeDecorator(ak, pubKeyGetter),
 		NewDapPubKeyDecorator(ak, pubKeyGetter), // SetPubKeyDecorator must be called before all signature verification decorators
-		NewDeductFeeDecorator(ak, sk, pubKeyGetter),
+		NewDeductFeeDecorator(ak, sk, dk, pubKeyGetter),
 		NewConsumeVerSignGasDecorator(ak, pubKeyGetter),
-		NewSigVerificationAndIncrementSequenceDecorator(ak, pubKeyGetter),
+		NewSigVerificationAndIncrementSequenceDecorator(ak, dk, pubKeyGetter),
 	)
 }
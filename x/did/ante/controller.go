@@ -0,0 +1,27 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+type controllerContextKey struct{}
+
+// WithSignatureController returns a context that carries the controller DID
+// named by a signature, so that a PubKeyGetter can resolve the key of the
+// controller instead of the key of the message signer.
+func WithSignatureController(ctx sdk.Context, signature IxoSignature) sdk.Context {
+	if signature.Controller == "" {
+		return ctx
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context(), controllerContextKey{}, signature.Controller))
+}
+
+// SignatureController returns the controller DID that signed on behalf of the
+// message signer, if any
+func SignatureController(ctx sdk.Context) (exported.Did, bool) {
+	controllerDid, ok := ctx.Context().Value(controllerContextKey{}).(exported.Did)
+	return controllerDid, ok && controllerDid != ""
+}
@@ -0,0 +1,50 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// CredentialKeeper defines the did keeper methods needed to enforce
+// credential requirements on messages.
+type CredentialKeeper interface {
+	GetCredentialRequirements(ctx sdk.Context, msgType string) []exported.CredentialRequirement
+	HasValidCredential(ctx sdk.Context, did exported.Did, credType string, issuers []exported.Did) bool
+}
+
+// CredentialRequirementDecorator rejects a tx if any of its messages is
+// subject to a credential requirement that its signer DID does not satisfy.
+type CredentialRequirementDecorator struct {
+	ck CredentialKeeper
+}
+
+func NewCredentialRequirementDecorator(ck CredentialKeeper) CredentialRequirementDecorator {
+	return CredentialRequirementDecorator{
+		ck: ck,
+	}
+}
+
+func (crd CredentialRequirementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgType := exported.MsgTypeKey(msg)
+		requirements := crd.ck.GetCredentialRequirements(ctx, msgType)
+		if len(requirements) == 0 {
+			continue
+		}
+
+		ixoMsg, ok := msg.(IxoMsg)
+		if !ok {
+			return ctx, Unauthorizedf("%s requires a credential but is not signed by a did", msgType)
+		}
+
+		signerDid := ixoMsg.GetSignerDid()
+		for _, cr := range requirements {
+			if !crd.ck.HasValidCredential(ctx, signerDid, cr.CredType, cr.Issuers) {
+				return ctx, ErrUnauthorizedPermission(fmt.Sprintf("%s requires a %s credential", msgType, cr.CredType))
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -41,7 +41,7 @@ func InvalidPubKey(m string) error {
 	return errors.Wrapf(errors.ErrInvalidPubKey, "PubKey error %s", m)
 }
 func InvalidPubKeyf(format string, a ...interface{}) error {
-	return errors.Wrapf(errors.ErrInvalidPubKey, format, a)
+	return errors.Wrapf(errors.ErrInvalidPubKey, format, a...)
 }
 func ErrInvalidBasicMsg(msg string) error {
 	errMsg := fmt.Sprintf("invalid basic message %s", msg)
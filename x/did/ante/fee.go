@@ -10,40 +10,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 type DeductFeeDecorator struct {
 	supplyKeeper types.SupplyKeeper
+	fk           FeeAllowanceKeeper
 	SigVerification
 }
-type OnlyOneMsgDecorator struct {
-	supplyKeeper types.SupplyKeeper
-	SigVerification
-}
-
-func NewOnlyOneMsgDecorator() OnlyOneMsgDecorator {
-	return OnlyOneMsgDecorator{}
-}
 
-// Number of messages in the tx must be 1
-
-func (g OnlyOneMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if len(tx.GetMsgs()) != 1 {
-		return ctx, IntErr("number of messages must be 1")
-	}
-	fmt.Println("✅  only one message pass ....")
-	return next(ctx, tx, simulate)
-}
-func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper, p PubKeyGetter) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper, fk FeeAllowanceKeeper, p PubKeyGetter) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		SigVerification: NewSigVerification(ak, p),
 		supplyKeeper:    sk,
+		fk:              fk,
 	}
 }
 
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	fmt.Println("--- DeductFeeDecorator .1")
-	sv, _, e := dfd.RetrievePubkey(ctx, tx, simulate)
+	sv, e := dfd.RetrievePubkeys(ctx, tx, simulate)
 	fmt.Println("--- DeductFeeDecorator .2")
 	if e != nil {
 		return ctx, InvalidTxDecodePubkeyNotFound(e)
@@ -54,12 +40,37 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	// a tx paid by a fee granter must be covered by one of its allowances,
+	// and may onboard new dids whose accounts are created here
+	var feePayer exported.Account
+	if granter := sv.dap_tx.FeeGranter; granter != "" {
+		granterAddr, err := dfd.fk.UseFeeAllowance(ctx, granter, sv.signers[0].did, sv.dap_tx.GetMsgs(), sv.dap_tx.GetFee())
+		if err != nil {
+			return ctx, err
+		}
+		if feePayer, err = auth.GetSignerAcc(ctx, dfd.ak, granterAddr); err != nil {
+			return ctx, err
+		}
+
+		var created []sdk.AccAddress
+		for _, signer := range sv.signers {
+			if dfd.ak.GetAccount(ctx, signer.address) == nil {
+				dfd.ak.SetAccount(ctx, dfd.ak.NewAccountWithAddress(ctx, signer.address))
+				created = append(created, signer.address)
+			}
+		}
+		ctx = withNewAccounts(ctx, created)
+	}
+
 	fmt.Println("--- DeductFeeDecorator .4")
 	// deduct the fees
 	if !sv.dap_tx.GetFee().IsZero() {
+		if feePayer == nil {
+			feePayer = sv.GetSignerAccount(ctx)
+		}
 
 		fmt.Println("--- DeductFeeDecorator .4.1")
-		if err = DeductFees(dfd.supplyKeeper, ctx, dfd.GetSignerAccount(ctx), sv.dap_tx.GetFee()); err != nil {
+		if err = DeductFees(dfd.supplyKeeper, ctx, feePayer, sv.dap_tx.GetFee()); err != nil {
 			return ctx, err
 		}
 
@@ -143,24 +154,25 @@ func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	// simulate gas cost for signatures in simulate mode
 	if simulate {
 
-		nsv, pubkeytm, e := cgts.RetrievePubkey(ctx, tx, simulate)
+		nsv, e := cgts.RetrievePubkeys(ctx, tx, simulate)
 		if e != nil {
 			return ctx, InvalidTxDecodePubkeyNotFound(e)
 		}
 
 		// use stdsignature to mock the size of a full signature
-
-		sigBz := types.ModuleCdc.MustMarshalBinaryLengthPrefixed(nsv.stdSignature)
-		cost := sdk.Gas(len(sigBz) + 6)
-
-		// If the pubkey is a multi-signature pubkey, then we estimate for the maximum
-		// number of signers.
-		if _, ok := pubkeytm.(multisig.PubKeyMultisigThreshold); ok {
-			cost *= params.TxSigLimit
+		for _, signer := range nsv.signers {
+			simSig := types.StdSignature{Signature: nsv.stdSignature.Signature, PubKey: signer.pubKey}
+			sigBz := types.ModuleCdc.MustMarshalBinaryLengthPrefixed(simSig)
+			cost := sdk.Gas(len(sigBz) + 6)
+
+			// If the pubkey is a multi-signature pubkey, then we estimate for the maximum
+			// number of signers.
+			if _, ok := signer.pubKey.(multisig.PubKeyMultisigThreshold); ok {
+				cost *= params.TxSigLimit
+			}
+
+			ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
 		}
-
-		ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*cost, "txSize")
-		//}
 	}
 	fmt.Println("✅  ConsumeTxSizeGasDecorator pass ....")
 	return next(ctx, tx, simulate)
@@ -177,19 +189,45 @@ func NewConsumeVerSignGasDecorator(ak keeper.AccountKeeper, p PubKeyGetter) Cons
 }
 func (svc ConsumeVerSignGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := svc.ak.GetParams(ctx)
-	if simulate {
-		nsv, pk, e := svc.RetrievePubkey(ctx, tx, simulate)
-		if e != nil {
-			return ctx, InvalidTxDecodePubkeyNotFound(e)
+	nsv, e := svc.RetrievePubkeys(ctx, tx, simulate)
+	if e != nil {
+		return ctx, InvalidTxDecodePubkeyNotFound(e)
+	}
+	for _, signer := range nsv.signers {
+		if simulate {
+			svc.consumeSimSigGas(nsv, ctx.GasMeter(), params, signer.pubKey)
+		}
+		key, ok := signer.pubKey.(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			consumeVerifyGas(ctx.GasMeter(), params, signer.pubKey)
+			continue
+		}
+
+		// a multisig key set is verified once for each of its keys at most,
+		// which is what a simulated tx is charged for
+		if simulate {
+			for _, subKey := range key.PubKeys {
+				consumeVerifyGas(ctx.GasMeter(), params, subKey)
+			}
+			continue
+		}
+		for _, subSignature := range signer.signature.SubSignatures {
+			consumeVerifyGas(ctx.GasMeter(), params, subSignature.verifyKey())
 		}
-		svc.consumeSimSigGas(nsv, ctx.GasMeter(), nsv.ak.GetParams(ctx), pk)
-	} else {
-		ctx.GasMeter().ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
 	}
 	fmt.Println("✅  ConsumeVerSignGasDecorator pass ....")
 	return next(ctx, tx, simulate)
 }
 
+// consumeVerifyGas charges for verifying one signature of a key of its type
+func consumeVerifyGas(gasmeter sdk.GasMeter, params auth.Params, pubKey crypto.PubKey) {
+	if _, ok := pubKey.(secp256k1.PubKeySecp256k1); ok {
+		gasmeter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+		return
+	}
+	gasmeter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+}
+
 // Simulated txs should not contain a signature and are not required to
 // contain a pubkey, so we must account for tx size of including an
 // IxoSignature and simulate gas consumption (assuming an ED25519 key).
@@ -197,6 +235,9 @@ func (svc ConsumeVerSignGasDecorator) consumeSimSigGas(signctx SigVerification,
 	simSig := IxoSignature{}
 	if len(signctx.stdSignature.Signature) == 0 {
 		simSig.SignatureValue = simEd25519Sig[:]
+		if _, ok := pubKey.(secp256k1.PubKeySecp256k1); ok {
+			simSig.SignatureValue = simSecp256k1Sig[:]
+		}
 	}
 	simSig.Created = simSig.Created.Add(1) // maximizes signature length
 
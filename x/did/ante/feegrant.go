@@ -0,0 +1,38 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// FlagFeeGranter names the DID whose fee allowance pays the fee of a tx
+const FlagFeeGranter = "fee-granter"
+
+// FeeAllowanceKeeper charges the fee of a tx to a fee allowance
+type FeeAllowanceKeeper interface {
+	UseFeeAllowance(ctx sdk.Context, granterDid, granteeDid exported.Did, msgs []sdk.Msg, fee sdk.Coins) (sdk.AccAddress, error)
+}
+
+type newAccountsContextKey struct{}
+
+// withNewAccounts returns a context that carries the signer accounts created
+// for a tx paid by a fee granter. Their owners could not know the account
+// numbers when signing, so they sign with account number 0.
+func withNewAccounts(ctx sdk.Context, addresses []sdk.AccAddress) sdk.Context {
+	if len(addresses) == 0 {
+		return ctx
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context(), newAccountsContextKey{}, addresses))
+}
+
+func isNewAccount(ctx sdk.Context, address sdk.AccAddress) bool {
+	addresses, _ := ctx.Context().Value(newAccountsContextKey{}).([]sdk.AccAddress)
+	for _, addr := range addresses {
+		if addr.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
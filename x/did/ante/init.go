@@ -11,6 +11,10 @@ import (
 
 const (
 	Ed25519SignatureLen = 64
+
+	// route of the did querier, which the ante package cannot import
+	didQuerierRoute  = "did"
+	queryDidSequence = "queryDidSequence"
 )
 
 var (
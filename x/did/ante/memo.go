@@ -0,0 +1,129 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// A structured memo starts with a tag, e.g. an invoice id, that is emitted as
+// an event attribute of the tx so that the tx can be found by the tag:
+//
+//	tag:INV-2020-0042 paid in full
+const (
+	MemoTagPrefix = "tag:"
+
+	EventTypeMemo       = "memo"
+	AttributeKeyMemoTag = "tag"
+
+	maxMemoTagLength = 64
+)
+
+var memoTagRegex = regexp.MustCompile(`^[a-zA-Z0-9._/-]+$`)
+
+type memoTagContextKey struct{}
+
+// ParseMemoTag returns the tag of a structured memo, or an empty tag if the
+// memo is not structured
+func ParseMemoTag(memo string) (string, error) {
+	if !strings.HasPrefix(memo, MemoTagPrefix) {
+		return "", nil
+	}
+	tag := strings.TrimPrefix(memo, MemoTagPrefix)
+	if i := strings.IndexAny(tag, " \t\n"); i >= 0 {
+		tag = tag[:i]
+	}
+	if err := ValidateMemoTag(tag); err != nil {
+		return "", err
+	}
+	return tag, nil
+}
+
+// ValidateMemoTag checks that a memo tag can be searched for in the tx index
+func ValidateMemoTag(tag string) error {
+	if len(tag) == 0 || len(tag) > maxMemoTagLength {
+		return errors.Wrapf(errors.ErrInvalidRequest, "memo tag must have 1 to %d characters", maxMemoTagLength)
+	}
+	if !memoTagRegex.MatchString(tag) {
+		return errors.Wrapf(errors.ErrInvalidRequest, "invalid memo tag %s", tag)
+	}
+	return nil
+}
+
+// MemoTagEvent returns the tx index query of the txs tagged with a memo tag
+func MemoTagEvent(tag string) string {
+	return fmt.Sprintf("%s.%s='%s'", EventTypeMemo, AttributeKeyMemoTag, tag)
+}
+
+// WithMemoTag returns a context that carries the memo tag of the tx
+func WithMemoTag(ctx sdk.Context, tag string) sdk.Context {
+	if tag == "" {
+		return ctx
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context(), memoTagContextKey{}, tag))
+}
+
+// MemoTag returns the memo tag of the tx, if any
+func MemoTag(ctx sdk.Context) (string, bool) {
+	tag, ok := ctx.Context().Value(memoTagContextKey{}).(string)
+	return tag, ok && tag != ""
+}
+
+// MemoTagDecorator rejects a tx with a malformed memo tag and passes the tag of
+// the tx on to the msg handlers. Events of the ante handler are not kept, so
+// the tag is emitted with the msg events by the MemoTagRouter.
+type MemoTagDecorator struct{}
+
+func NewMemoTagDecorator() MemoTagDecorator {
+	return MemoTagDecorator{}
+}
+
+func (mtd MemoTagDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(interface{ GetMemo() string })
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	tag, err := ParseMemoTag(memoTx.GetMemo())
+	if err != nil {
+		return ctx, err
+	}
+	return next(WithMemoTag(ctx, tag), tx, simulate)
+}
+
+// MemoTagRouter is a msg router whose handlers emit the memo tag of the tx
+// with the events of every msg
+type MemoTagRouter struct {
+	sdk.Router
+}
+
+func NewMemoTagRouter(router sdk.Router) MemoTagRouter {
+	return MemoTagRouter{router}
+}
+
+func (r MemoTagRouter) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, h)
+	return r
+}
+
+func (r MemoTagRouter) Route(ctx sdk.Context, path string) sdk.Handler {
+	handler := r.Router.Route(ctx, path)
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return res, err
+		}
+		if tag, ok := MemoTag(ctx); ok && res != nil {
+			res.Events = append(res.Events, sdk.NewEvent(EventTypeMemo,
+				sdk.NewAttribute(AttributeKeyMemoTag, tag),
+			))
+		}
+		return res, nil
+	}
+}
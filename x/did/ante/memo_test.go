@@ -0,0 +1,73 @@
+package ante
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemoTag(t *testing.T) {
+	tag, err := ParseMemoTag("tag:INV-2020-0042 paid in full")
+	require.Nil(t, err)
+	require.Equal(t, "INV-2020-0042", tag)
+
+	tag, err = ParseMemoTag("tag:orders/7")
+	require.Nil(t, err)
+	require.Equal(t, "orders/7", tag)
+
+	// a memo that is not structured has no tag
+	tag, err = ParseMemoTag("paid in full, tag:INV-1")
+	require.Nil(t, err)
+	require.Empty(t, tag)
+
+	// a tag that cannot be searched for is rejected
+	_, err = ParseMemoTag("tag: INV-1")
+	require.NotNil(t, err)
+	_, err = ParseMemoTag("tag:INV'1")
+	require.NotNil(t, err)
+	_, err = ParseMemoTag("tag:" + string(make([]byte, maxMemoTagLength+1)))
+	require.NotNil(t, err)
+
+	require.Equal(t, "memo.tag='INV-1'", MemoTagEvent("INV-1"))
+}
+
+func TestMemoTagEvents(t *testing.T) {
+	ctx, _ := createTestAccountKeeper()
+	msg := testIxoMsg{"did:dxp:UDH4t88ebNMbdFXAgpwcgD"}
+	sig := NewSignature(time.Now(), []byte("signature"))
+
+	router := NewMemoTagRouter(baseapp.NewRouter())
+	router.AddRoute(msg.Route(), func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	})
+	anteHandler := sdk.ChainAnteDecorators(NewMemoTagDecorator())
+	deliver := func(tx sdk.Tx) (sdk.Events, error) {
+		newCtx, err := anteHandler(ctx, tx, false)
+		if err != nil {
+			return nil, err
+		}
+		res, err := router.Route(newCtx, msg.Route())(newCtx, msg)
+		require.Nil(t, err)
+		return res.Events, nil
+	}
+
+	events, err := deliver(NewIxoTx([]sdk.Msg{msg}, auth.StdFee{}, []IxoSignature{sig}, "tag:INV-1 first invoice"))
+	require.Nil(t, err)
+	require.Equal(t, sdk.Events{sdk.NewEvent(EventTypeMemo, sdk.NewAttribute(AttributeKeyMemoTag, "INV-1"))}, events)
+
+	events, err = deliver(auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "tag:INV-2"))
+	require.Nil(t, err)
+	require.Equal(t, sdk.Events{sdk.NewEvent(EventTypeMemo, sdk.NewAttribute(AttributeKeyMemoTag, "INV-2"))}, events)
+
+	// a tx without a tag emits no memo event
+	events, err = deliver(NewIxoTx([]sdk.Msg{msg}, auth.StdFee{}, []IxoSignature{sig}, "first invoice"))
+	require.Nil(t, err)
+	require.Empty(t, events)
+
+	_, err = deliver(NewIxoTx([]sdk.Msg{msg}, auth.StdFee{}, []IxoSignature{sig}, "tag:"))
+	require.NotNil(t, err)
+}
@@ -8,10 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/tendermint/tendermint/crypto"
 	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tokenchain/dp-hub/x/did/ed25519"
 	"github.com/tokenchain/dp-hub/x/did/exported"
-
-	"time"
 )
 
 // DidKeeper defines the did contract that must be fulfilled throughout the ixo module
@@ -27,21 +27,32 @@ type (
 		GetAllDidDocs(ctx sdk.Context) (didDocs []exported.DidDoc)
 		GetAddDids(ctx sdk.Context) (dids []exported.Did)
 	}
+	// DidSequenceKeeper stores the sequence of every DID that signs a tx
+	DidSequenceKeeper interface {
+		GetDidSequence(ctx sdk.Context, did exported.Did) (uint64, bool)
+		SetDidSequence(ctx sdk.Context, did exported.Did, sequence uint64)
+	}
 	SigVerification struct {
-		ak              auth.AccountKeeper
-		stdSignature    auth.StdSignature
-		account_address []byte
-		pubkey          []byte
-		dap_tx          IxoTx
-		pgetter         PubKeyGetter
-		signature       IxoSignature
+		ak           auth.AccountKeeper
+		stdSignature auth.StdSignature
+		dap_tx       IxoTx
+		pgetter      PubKeyGetter
+		signers      []ixoSigner
+	}
+	// ixoSigner is a DID that signs an IxoTx together with the key resolved
+	// for its messages and its signature in the tx
+	ixoSigner struct {
+		did       exported.Did
+		pubKey    crypto.PubKey
+		address   sdk.AccAddress
+		signature IxoSignature
 	}
 	DapPubKeyDecoratorDecorator struct {
 		SigVerification
 	}
 	SigVerificationDecorator struct {
 		SigVerification
-		signature IxoSignature
+		sk DidSequenceKeeper
 	}
 )
 
@@ -54,8 +65,13 @@ func NewSigVerification(ak auth.AccountKeeper, p PubKeyGetter) SigVerification {
 func (sv SigVerification) isGenesis(ctx sdk.Context) bool {
 	return ctx.BlockHeight() == 0
 }
+
+// GetSignerAccount returns the account of the first signer, which pays the fees
 func (sv SigVerification) GetSignerAccount(ctx sdk.Context) aexported.Account {
-	signerAcc, err := auth.GetSignerAcc(ctx, sv.ak, sv.account_address)
+	return sv.getAccount(ctx, sv.signers[0].address)
+}
+func (sv SigVerification) getAccount(ctx sdk.Context, address sdk.AccAddress) aexported.Account {
+	signerAcc, err := auth.GetSignerAcc(ctx, sv.ak, address)
 	if err != nil {
 		panic(" cannot get the GetSignerAccont")
 	}
@@ -71,89 +87,103 @@ func (sv SigVerification) RefreshAccount(ctx sdk.Context) error {
 	return nil
 }
 
-func (sv SigVerification) initializeSignatures() (nsv SigVerification, err error) {
-	//f := sv.tx.GetFirstSignatureValues()
-	if len(sv.dap_tx.Signatures) > 0 {
-		sv.signature = NewSignature(time.Now(), sv.dap_tx.GetFirstSignature())
-		return sv, nil
-	} else {
-		return sv, ErrItemNotFound("tx signature not found")
-	}
-}
-
-func (sv SigVerification) RetrievePubkey(ctx sdk.Context, tx sdk.Tx, simulate bool) (nsv SigVerification, pubKey crypto.PubKey, err error) {
-	fmt.Println("--- RetrievePubkey .1")
+// RetrievePubkeys resolves the key and account of every DID that signs the
+// tx. Each signer DID is resolved through the first message it signs, using
+// the controller named by its signature if there is one. Every other message
+// of the DID must resolve to the same key, so that a message which sets the
+// key of a DID cannot lend that key to the messages batched with it.
+func (sv SigVerification) RetrievePubkeys(ctx sdk.Context, tx sdk.Tx, simulate bool) (nsv SigVerification, err error) {
 	sigTx, ok := tx.(IxoTx)
 	if !ok {
-		fmt.Println("--- RetrievePubkey .1.1")
-		fmt.Println(tx.ValidateBasic())
 		fmt.Println(tx)
-		return sv, nil, InvalidTxDecode()
+		return sv, InvalidTxDecode()
 	}
-	//fmt.Println(sigTx)
-	//fmt.Println(*sv.tx)
-	// all messages must be of type IxoMsg
-	fmt.Println("--- RetrievePubkey .1.3")
-	msg, ok := sigTx.GetMsgs()[0].(IxoMsg)
-	if !ok {
-		//gInfo = sdk.GasInfo{}
-		return sv, nil, IntErr("msg must be ixo.IxoMsg. dxp")
-	}
-	fmt.Println("--- RetrievePubkey .1.4")
-	fmt.Println(msg)
-	pubKey, err = sv.pgetter(ctx, msg)
+
+	signerMsgs, err := sigTx.getSignerMsgs()
 	if err != nil {
-		fmt.Println("--- RetrievePubkey .1.5")
-		return sv, nil, err
+		return sv, err
 	}
-	fmt.Println("--- RetrievePubkey .2")
-	address := sdk.AccAddress(pubKey.Address())
-	fmt.Println("--- RetrievePubkey .3")
-	signerAcc, err := auth.GetSignerAcc(ctx, sv.ak, address)
-	//signer := sigTx.GetSigner()
-	//acc := sv.ak.GetAccount(ctx, signer)
-	fmt.Println("--- RetrievePubkey .4")
-	if signerAcc != nil {
-		sv.account_address = address
-		//copy(sv.account_address, address.Bytes())
-		//fmt.Println("check-RetrievePubkey check value pass ....")
-		//fmt.Println(address)
-
-	} else {
-		return sv, nil, UnknownAddress("the signer account address is not found.")
+	if !simulate && len(sigTx.Signatures) != len(signerMsgs) {
+		return sv, Unauthorizedf("wrong number of signers; expected %d, got %d", len(signerMsgs), len(sigTx.Signatures))
 	}
 
-	if simulate {
-		// In simulate mode the transaction comes with no signatures, thus if the
-		// account's pubkey is nil, both signature verification and gasKVStore.Set()
-		// shall consume the largest amount, i.e. it takes more gas to verify
-		// secp256k1 keys than ed25519 ones.
-
-		if pubKey == nil {
-			//copy(sv.publicKey[:], simSecp256k1Pubkey[:])
-			return sv, nil, InvalidPubKey("there is no valid public key to use for simulation.")
+	signers := make([]ixoSigner, len(signerMsgs))
+	seen := make(map[string]bool, len(signerMsgs))
+	for i, msg := range signerMsgs {
+		var signature IxoSignature
+		if i < len(sigTx.Signatures) {
+			signature = sigTx.Signatures[i]
 		}
-	}
 
-	/*	err = signerAcc.SetPubKey(sv.publicKey)
+		pubKey, err := sv.pgetter(WithSignatureController(ctx, signature), msg)
 		if err != nil {
-			return InvalidPubKey(err.Error())
+			return sv, err
 		}
-	*/
-	simSig := types.StdSignature{
-		Signature: simSecp256k1Sig[:],
-		PubKey:    pubKey,
+		if simulate && pubKey == nil {
+			// In simulate mode the transaction comes with no signatures, thus if the
+			// account's pubkey is nil, both signature verification and gasKVStore.Set()
+			// shall consume the largest amount.
+			return sv, InvalidPubKey("there is no valid public key to use for simulation.")
+		}
+
+		// the account of a new did is created when a fee granter pays for it
+		address := sdk.AccAddress(pubKey.Address())
+		if signerAcc, _ := auth.GetSignerAcc(ctx, sv.ak, address); signerAcc == nil && sigTx.FeeGranter == "" {
+			return sv, UnknownAddress("the signer account address is not found.")
+		}
+		// each signature increments the sequence of its account, so an
+		// account cannot sign twice
+		if seen[address.String()] {
+			return sv, Unauthorizedf("account %s signs for more than one did", address)
+		}
+		seen[address.String()] = true
+
+		signers[i] = ixoSigner{
+			did:       msg.GetSignerDid(),
+			pubKey:    pubKey,
+			address:   address,
+			signature: signature,
+		}
+	}
+
+	if err := sv.checkSignerKeys(ctx, sigTx, signers); err != nil {
+		return sv, err
 	}
 
-	sv.stdSignature = simSig
+	sv.stdSignature = types.StdSignature{
+		Signature: simSecp256k1Sig[:],
+		PubKey:    signers[0].pubKey,
+	}
 	sv.dap_tx = sigTx
-	//var pubkey_orginal ed25519tm.PubKeyEd25519
-	//copy(pubkey_orginal[:], pubKey.Bytes()[5:])
-	//sv.pubkey = pubkey_orginal[:]
-	//fmt.Println(sv.account_address)
-	//sv.pubkey = ed25519tm.PubKeyEd25519(pubKey)
+	sv.signers = signers
+	return sv, nil
+}
 
-	return sv, pubKey, nil
+// checkSignerKeys resolves the messages after the first of each signer DID
+// and rejects the tx if any of them resolves to another key than the signer's
+func (sv SigVerification) checkSignerKeys(ctx sdk.Context, sigTx IxoTx, signers []ixoSigner) error {
+	index := make(map[exported.Did]int, len(signers))
+	for i, signer := range signers {
+		index[signer.did] = i
+	}
+	resolved := make(map[exported.Did]bool, len(signers))
+	for _, msg := range sigTx.Msgs {
+		ixoMsg := msg.(IxoMsg)
+		did := ixoMsg.GetSignerDid()
+		if !resolved[did] {
+			resolved[did] = true
+			continue
+		}
+		signer := signers[index[did]]
+		pubKey, err := sv.pgetter(WithSignatureController(ctx, signer.signature), ixoMsg)
+		if err != nil {
+			return err
+		}
+		if pubKey == nil || !pubKey.Equals(signer.pubKey) {
+			return Unauthorizedf("messages of %s resolve to different keys", did)
+		}
+	}
+	return nil
 }
 
 func NewDapPubKeyDecorator(ak auth.AccountKeeper, p PubKeyGetter) DapPubKeyDecoratorDecorator {
@@ -162,19 +192,28 @@ func NewDapPubKeyDecorator(ak auth.AccountKeeper, p PubKeyGetter) DapPubKeyDecor
 	}
 }
 func (__edp DapPubKeyDecoratorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	fmt.Println("--- DapPubKeyDecoratorDecorator .1")
-	if _, _, e := __edp.RetrievePubkey(ctx, tx, simulate); e != nil {
-		fmt.Println("--- DapPubKeyDecoratorDecorator .2")
+	if _, e := __edp.RetrievePubkeys(ctx, tx, simulate); e != nil {
 		return ctx, InvalidTxDecodePubkeyNotFound(e)
 	}
 	fmt.Println("✅  DapPubKeyDecoratorDecorator pass")
 	return next(ctx, tx, simulate)
 }
 
-func NewSigVerificationAndIncrementSequenceDecorator(ak auth.AccountKeeper, p PubKeyGetter) SigVerificationDecorator {
+func NewSigVerificationAndIncrementSequenceDecorator(ak auth.AccountKeeper, sk DidSequenceKeeper, p PubKeyGetter) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		SigVerification: NewSigVerification(ak, p),
+		sk:              sk,
+	}
+}
+
+// signerSequence returns the sequence of a signer DID. A DID that has not
+// signed since sequences are stored per DID takes over the sequence of its
+// signing account, so that txs signed before cannot be replayed.
+func (sv SigVerificationDecorator) signerSequence(ctx sdk.Context, did exported.Did, acc aexported.Account) uint64 {
+	if sequence, found := sv.sk.GetDidSequence(ctx, did); found {
+		return sequence
 	}
+	return acc.GetSequence()
 }
 
 func (sv SigVerificationDecorator) VerifyNow(pub []byte, message []byte, sign []byte) error {
@@ -184,11 +223,6 @@ func (sv SigVerificationDecorator) VerifyNow(pub []byte, message []byte, sign []
 	if l := len(pub); l != ed25519.PublicKeySize {
 		return Unauthorizedf("ed25519: bad public key length expected %d but got %d! ", ed25519.PublicKeySize, l)
 	}
-	/*
-		fmt.Println("===> debug public key check:", base58.Encode(pub), len(pub), pub)
-		fmt.Println("===> ⚠️ check signed message data ....")
-		fmt.Println(message)
-	*/
 	if ed25519.Verify(pub, message, sign) {
 		return nil
 	} else {
@@ -196,41 +230,99 @@ func (sv SigVerificationDecorator) VerifyNow(pub []byte, message []byte, sign []
 	}
 }
 
-func (sv SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	nsv, pp, e := sv.RetrievePubkey(ctx, tx, simulate)
-	if e != nil {
-		return ctx, InvalidTxDecodePubkeyNotFound(e)
-	}
-	nsv2, e := nsv.initializeSignatures()
-	if e != nil {
-		return ctx, e
+// verifyKey returns the key of a sub-signature, or an empty ed25519 key if
+// it does not parse, which fails verification
+func (subSignature IxoSubSignature) verifyKey() crypto.PubKey {
+	pub, err := exported.ParseVerifyKey(subSignature.PubKey)
+	if err != nil {
+		return ed25519tm.PubKeyEd25519{}
 	}
+	return pub
+}
 
-	signedMessageBytes := nsv2.dap_tx.GetSignBytes(ctx, nsv2.GetSignerAccount(ctx))
-	/*
+// VerifyMultisig checks that at least the threshold of distinct keys of the
+// multisig key signed the message
+func (sv SigVerificationDecorator) VerifyMultisig(key multisig.PubKeyMultisigThreshold, message []byte, subSignatures []IxoSubSignature) error {
+	signed := make([]bool, len(key.PubKeys))
+	count := uint(0)
+	for _, subSignature := range subSignatures {
+		pub, err := exported.ParseVerifyKey(subSignature.PubKey)
+		if err != nil {
+			return Unauthorizedf("%s is not a key of the multisig key set", subSignature.PubKey)
+		}
+		index := -1
+		for i, pubKey := range key.PubKeys {
+			if pubKey.Equals(pub) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return Unauthorizedf("%s is not a key of the multisig key set", subSignature.PubKey)
+		} else if signed[index] {
+			return Unauthorizedf("%s signed more than once", subSignature.PubKey)
+		}
+		switch pub := pub.(type) {
+		case ed25519tm.PubKeyEd25519:
+			if er := sv.VerifyNow(pub[:], message, subSignature.SignatureValue); er != nil {
+				return er
+			}
+		default:
+			if !pub.VerifyBytes(message, subSignature.SignatureValue) {
+				return Unauthorized("Signature Verification failed. dxp secp256k1.")
+			}
+		}
+		signed[index] = true
+		count++
+	}
 
-		fmt.Println("✅  check signature data ....")
-		fmt.Println(nsv2.signature.SignatureValue[:])
+	if count < key.K {
+		return Unauthorizedf("multisig requires %d signatures but got %d", key.K, count)
+	}
+	return nil
+}
 
-	*/
+func (sv SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	nsv, e := sv.RetrievePubkeys(ctx, tx, simulate)
+	if e != nil {
+		return ctx, InvalidTxDecodePubkeyNotFound(e)
+	}
 
-	/*if !simulate && !pp.VerifyBytes(signedMessageBytes, nsv2.signature.SignatureValue[:]) {
-		return ctx, Unauthorized("Signature Verification failed. dxp")
-	}*/
-	if !simulate {
-		key := pp.(ed25519tm.PubKeyEd25519)
-		if er := sv.VerifyNow(key[:], signedMessageBytes, nsv2.signature.SignatureValue[:]); er != nil {
-			return ctx, er
+	for _, signer := range nsv.signers {
+		acc := nsv.getAccount(ctx, signer.address)
+		sequence := sv.signerSequence(ctx, signer.did, acc)
+		if !simulate {
+			signedMessageBytes := nsv.dap_tx.GetSignBytes(ctx, acc, sequence)
+			if isNewAccount(ctx, signer.address) {
+				signedMessageBytes = nsv.dap_tx.signBytes(ctx.ChainID(), 0, sequence)
+			}
+			switch key := signer.pubKey.(type) {
+			case ed25519tm.PubKeyEd25519:
+				if er := sv.VerifyNow(key[:], signedMessageBytes, signer.signature.SignatureValue[:]); er != nil {
+					return ctx, er
+				}
+			case secp256k1.PubKeySecp256k1:
+				if !key.VerifyBytes(signedMessageBytes, signer.signature.SignatureValue) {
+					return ctx, Unauthorized("Signature Verification failed. dxp secp256k1.")
+				}
+			case multisig.PubKeyMultisigThreshold:
+				if er := sv.VerifyMultisig(key, signedMessageBytes, signer.signature.SubSignatures); er != nil {
+					return ctx, er
+				}
+			default:
+				return ctx, InvalidPubKeyf("unsupported public key type for %s: %T", signer.did, signer.pubKey)
+			}
 		}
-		fmt.Println("✅  SigVerificationDecorator pass ....")
-	}
 
-	acc := nsv2.GetSignerAccount(ctx)
-	// increment account sequence
-	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
-		return ctx, InvalidTxDecodeMsg(err.Error())
+		// increment the did sequence, which invalidates the signature for
+		// any later tx, and the account sequence
+		sv.sk.SetDidSequence(ctx, signer.did, sequence+1)
+		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+			return ctx, InvalidTxDecodeMsg(err.Error())
+		}
+		sv.ak.SetAccount(ctx, acc)
 	}
+	fmt.Println("✅  SigVerificationDecorator pass ....")
 
-	sv.ak.SetAccount(ctx, nsv2.GetSignerAccount(ctx))
 	return next(ctx, tx, simulate)
 }
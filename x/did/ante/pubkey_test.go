@@ -0,0 +1,224 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const testChainID = "test-chain"
+
+type testSequenceKeeper map[exported.Did]uint64
+
+func (k testSequenceKeeper) GetDidSequence(_ sdk.Context, did exported.Did) (uint64, bool) {
+	sequence, found := k[did]
+	return sequence, found
+}
+
+func (k testSequenceKeeper) SetDidSequence(_ sdk.Context, did exported.Did, sequence uint64) {
+	k[did] = sequence
+}
+
+// recordingGasMeter records the gas consumed per descriptor
+type recordingGasMeter struct {
+	sdk.GasMeter
+	consumed map[string]uint64
+}
+
+func (m recordingGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	m.consumed[descriptor] += amount
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func createTestAccountKeeper() (sdk.Context, auth.AccountKeeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	_ = ms.LoadLatestVersion()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: testChainID, Height: 1}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	ak.SetParams(ctx, auth.DefaultParams())
+	return ctx, ak
+}
+
+func TestSigVerificationReplay(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	signer := exported.NewDidGeneratorBuilder().Build()
+	pubKey := exported.VerifyKeyToPublicKeyEd25519(signer.VerifyKey)
+	pubKeyGetter := func(sdk.Context, IxoMsg) (crypto.PubKey, error) { return pubKey, nil }
+
+	acc := ak.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+	ak.SetAccount(ctx, acc)
+
+	svd := NewSigVerificationAndIncrementSequenceDecorator(ak, sk, pubKeyGetter)
+	anteHandler := sdk.ChainAnteDecorators(svd)
+	signedTx := func(chainID string, sequence uint64) IxoTx {
+		tx, err := SignStdSignMsg(signer, auth.StdSignMsg{
+			ChainID:       chainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sequence,
+			Msgs:          []sdk.Msg{testIxoMsg{signer.Did}},
+		}, "")
+		require.Nil(t, err)
+		return tx
+	}
+
+	tx := signedTx(testChainID, 0)
+	_, err := anteHandler(ctx, tx, false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), sk[signer.Did])
+
+	// the accepted tx cannot be submitted again
+	_, err = anteHandler(ctx, tx, false)
+	require.NotNil(t, err)
+	require.Equal(t, uint64(1), sk[signer.Did])
+
+	// nor a tx signed for another chain
+	_, err = anteHandler(ctx, signedTx("other-chain", 1), false)
+	require.NotNil(t, err)
+
+	_, err = anteHandler(ctx, signedTx(testChainID, 1), false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), sk[signer.Did])
+	require.Equal(t, uint64(2), ak.GetAccount(ctx, acc.GetAddress()).GetSequence())
+}
+
+func TestSigVerificationAccountSequenceFallback(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	signer := exported.NewDidGeneratorBuilder().Build()
+	pubKey := exported.VerifyKeyToPublicKeyEd25519(signer.VerifyKey)
+	pubKeyGetter := func(sdk.Context, IxoMsg) (crypto.PubKey, error) { return pubKey, nil }
+
+	// an account that signed txs before sequences were stored per did
+	acc := ak.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+	require.Nil(t, acc.SetSequence(5))
+	ak.SetAccount(ctx, acc)
+
+	anteHandler := sdk.ChainAnteDecorators(NewSigVerificationAndIncrementSequenceDecorator(ak, sk, pubKeyGetter))
+	signedTx := func(sequence uint64) IxoTx {
+		tx, err := SignStdSignMsg(signer, auth.StdSignMsg{
+			ChainID:       testChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sequence,
+			Msgs:          []sdk.Msg{testIxoMsg{signer.Did}},
+		}, "")
+		require.Nil(t, err)
+		return tx
+	}
+
+	// a tx signed with an earlier sequence of the account is rejected
+	_, err := anteHandler(ctx, signedTx(4), false)
+	require.NotNil(t, err)
+
+	_, err = anteHandler(ctx, signedTx(5), false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), sk[signer.Did])
+}
+
+func TestSigVerificationFeeGranterNewAccount(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	signer := exported.NewDidGeneratorBuilder().Build()
+	granter := exported.NewDidGeneratorBuilder().Build()
+	pubKey := exported.VerifyKeyToPublicKeyEd25519(signer.VerifyKey)
+	pubKeyGetter := func(sdk.Context, IxoMsg) (crypto.PubKey, error) { return pubKey, nil }
+
+	// take account number 0 so that the new account gets another one
+	ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, sdk.AccAddress(granter.Address())))
+	acc := ak.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+	require.NotEqual(t, uint64(0), acc.GetAccountNumber())
+	ak.SetAccount(ctx, acc)
+
+	tx, err := SignStdSignMsg(signer, auth.StdSignMsg{
+		ChainID: testChainID,
+		Msgs:    []sdk.Msg{testIxoMsg{signer.Did}},
+	}, granter.Did)
+	require.Nil(t, err)
+	require.Equal(t, granter.Did, tx.FeeGranter)
+
+	anteHandler := sdk.ChainAnteDecorators(NewSigVerificationAndIncrementSequenceDecorator(ak, sk, pubKeyGetter))
+
+	// the signature is only accepted with account number 0 for a new account
+	_, err = anteHandler(ctx, tx, false)
+	require.NotNil(t, err)
+
+	newCtx := withNewAccounts(ctx, []sdk.AccAddress{acc.GetAddress()})
+	require.True(t, isNewAccount(newCtx, acc.GetAddress()))
+
+	// the fee granter is covered by the signature
+	tampered := tx
+	tampered.FeeGranter = ""
+	_, err = anteHandler(newCtx, tampered, false)
+	require.NotNil(t, err)
+
+	_, err = anteHandler(newCtx, tx, false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), sk[signer.Did])
+}
+
+func TestSigVerificationSecp256k1(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	signer := exported.NewDidGeneratorBuilder().WithKeyType(exported.KeyTypeSecp256k1).Build()
+	require.True(t, exported.IsValidDid(signer.Did))
+	pubKey, err := signer.VerifyPubKey()
+	require.Nil(t, err)
+	require.IsType(t, secp256k1.PubKeySecp256k1{}, pubKey)
+	require.Equal(t, sdk.AccAddress(pubKey.Address()), signer.Address())
+	pubKeyGetter := func(sdk.Context, IxoMsg) (crypto.PubKey, error) { return pubKey, nil }
+
+	acc := ak.NewAccountWithAddress(ctx, signer.Address())
+	ak.SetAccount(ctx, acc)
+
+	signedTx := func(sequence uint64) IxoTx {
+		tx, err := SignStdSignMsg(signer, auth.StdSignMsg{
+			ChainID:       testChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sequence,
+			Msgs:          []sdk.Msg{testIxoMsg{signer.Did}},
+		}, "")
+		require.Nil(t, err)
+		return tx
+	}
+
+	// verifying the signature is charged at the secp256k1 cost
+	meter := recordingGasMeter{sdk.NewInfiniteGasMeter(), map[string]uint64{}}
+	gasHandler := sdk.ChainAnteDecorators(NewConsumeVerSignGasDecorator(ak, pubKeyGetter))
+	_, err = gasHandler(ctx.WithGasMeter(meter), signedTx(0), false)
+	require.Nil(t, err)
+	require.Equal(t, ak.GetParams(ctx).SigVerifyCostSecp256k1, meter.consumed["ante verify: secp256k1"])
+	require.Zero(t, meter.consumed["ante verify: ed25519"])
+
+	anteHandler := sdk.ChainAnteDecorators(NewSigVerificationAndIncrementSequenceDecorator(ak, sk, pubKeyGetter))
+	_, err = anteHandler(ctx, signedTx(1), false)
+	require.NotNil(t, err)
+
+	_, err = anteHandler(ctx, signedTx(0), false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), sk[signer.Did])
+}
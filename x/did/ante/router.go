@@ -0,0 +1,118 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SignerRouter holds the signer resolver of the IxoMsgs of each module, by the
+// route of the module. A module registers its resolver once and the IxoTx ante
+// handler resolves the signers of every msg through the router, so a tx may
+// batch msgs of several modules.
+type SignerRouter struct {
+	pubKeyGetters map[string]PubKeyGetter
+	anteHandlers  map[string]sdk.AnteHandler
+}
+
+// NewSignerRouter returns a router without routes
+func NewSignerRouter() *SignerRouter {
+	return &SignerRouter{
+		pubKeyGetters: make(map[string]PubKeyGetter),
+		anteHandlers:  make(map[string]sdk.AnteHandler),
+	}
+}
+
+// AddRoute registers the signer resolver of the msgs of a route
+func (r *SignerRouter) AddRoute(route string, pubKeyGetter PubKeyGetter) *SignerRouter {
+	if !sdk.IsAlphaNumeric(route) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+	if r.HasRoute(route) {
+		panic(fmt.Sprintf("route %s has already been initialized", route))
+	}
+	r.pubKeyGetters[route] = pubKeyGetter
+	return r
+}
+
+// AddAnteHandler registers an ante handler that replaces the IxoTx ante
+// handler for a msg type. A msg of the type cannot be batched with other msgs.
+func (r *SignerRouter) AddAnteHandler(route, msgType string, anteHandler sdk.AnteHandler) *SignerRouter {
+	if !r.HasRoute(route) {
+		panic(fmt.Sprintf("route %s has no signer resolver", route))
+	}
+	key := msgTypeKey(route, msgType)
+	if _, found := r.anteHandlers[key]; found {
+		panic(fmt.Sprintf("ante handler of %s has already been initialized", key))
+	}
+	r.anteHandlers[key] = anteHandler
+	return r
+}
+
+// HasRoute checks if a route has a signer resolver
+func (r *SignerRouter) HasRoute(route string) bool {
+	_, found := r.pubKeyGetters[route]
+	return found
+}
+
+// PubKeyGetter returns the resolver that resolves the signer of a msg with the
+// resolver of the route of the msg
+func (r *SignerRouter) PubKeyGetter() PubKeyGetter {
+	return func(ctx sdk.Context, msg IxoMsg) (crypto.PubKey, error) {
+		pubKeyGetter, found := r.pubKeyGetters[msg.Route()]
+		if !found {
+			return nil, UnknownRequest(fmt.Sprintf("no signer resolver for route %s", msg.Route()))
+		}
+		return pubKeyGetter(ctx, msg)
+	}
+}
+
+// anteHandler returns the ante handler that replaces the IxoTx ante handler
+// for the msgs of a tx, if any
+func (r *SignerRouter) anteHandler(msgs []sdk.Msg) (sdk.AnteHandler, error) {
+	for _, msg := range msgs {
+		if !r.HasRoute(msg.Route()) {
+			return nil, UnknownRequest(fmt.Sprintf("no signer resolver for route %s", msg.Route()))
+		}
+		anteHandler, found := r.anteHandlers[msgTypeKey(msg.Route(), msg.Type())]
+		if !found {
+			continue
+		}
+		if len(msgs) != 1 {
+			return nil, IntErr(fmt.Sprintf("%s cannot be batched with other messages", msgTypeKey(msg.Route(), msg.Type())))
+		}
+		return anteHandler, nil
+	}
+	return nil, nil
+}
+
+func msgTypeKey(route, msgType string) string {
+	return route + "/" + msgType
+}
+
+// NewRouterAnteHandler returns the ante handler that verifies a tx by its
+// decoded type. An IxoTx is verified by the IxoTx ante handler, which should
+// resolve signers through the PubKeyGetter of the router, or by the ante
+// handler registered for its msg type. A StdTx is verified by the StdTx ante
+// handler.
+func NewRouterAnteHandler(router *SignerRouter, ixoTxAnteHandler, stdTxAnteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		switch tx := tx.(type) {
+		case IxoTx:
+			anteHandler, err := router.anteHandler(tx.GetMsgs())
+			if err != nil {
+				return ctx, err
+			}
+			if anteHandler != nil {
+				return anteHandler(ctx, tx, simulate)
+			}
+			return ixoTxAnteHandler(ctx, tx, simulate)
+		case auth.StdTx:
+			return stdTxAnteHandler(ctx, tx, simulate)
+		default:
+			return ctx, InvalidTxDecode()
+		}
+	}
+}
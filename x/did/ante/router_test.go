@@ -0,0 +1,199 @@
+package ante
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// testRouteMsg is a testIxoMsg of another module
+type testRouteMsg struct {
+	testIxoMsg
+	route   string
+	msgType string
+}
+
+func (msg testRouteMsg) Route() string { return msg.route }
+func (msg testRouteMsg) Type() string  { return msg.msgType }
+
+func TestRouterAnteHandlerMixedModules(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	signerA := exported.NewDidGeneratorBuilder().Build()
+	signerB := exported.NewDidGeneratorBuilder().WithKeyType(exported.KeyTypeSecp256k1).Build()
+	pubKeyB, err := signerB.VerifyPubKey()
+	require.Nil(t, err)
+
+	// each module resolves only the signers of its own msgs
+	resolver := func(signer exported.IxoDid, pubKey crypto.PubKey) PubKeyGetter {
+		return func(_ sdk.Context, msg IxoMsg) (crypto.PubKey, error) {
+			if msg.GetSignerDid() != signer.Did {
+				return nil, Unauthorized("unknown signer")
+			}
+			return pubKey, nil
+		}
+	}
+	router := NewSignerRouter().
+		AddRoute("alpha", resolver(signerA, exported.VerifyKeyToPublicKeyEd25519(signerA.VerifyKey))).
+		AddRoute("beta", resolver(signerB, pubKeyB))
+
+	var stdTxs int
+	stdTxAnteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		stdTxs++
+		return ctx, nil
+	}
+	ixoTxAnteHandler := sdk.ChainAnteDecorators(NewSigVerificationAndIncrementSequenceDecorator(ak, sk, router.PubKeyGetter()))
+	anteHandler := NewRouterAnteHandler(router, ixoTxAnteHandler, stdTxAnteHandler)
+
+	accA := ak.NewAccountWithAddress(ctx, signerA.Address())
+	ak.SetAccount(ctx, accA)
+	accB := ak.NewAccountWithAddress(ctx, signerB.Address())
+	ak.SetAccount(ctx, accB)
+
+	signedTx := func(msgs ...sdk.Msg) IxoTx {
+		signMsg := func(signer exported.IxoDid, acc sdk.AccAddress) IxoSignature {
+			return SignMsgForSignature(signer, auth.StdSignMsg{
+				ChainID:       testChainID,
+				AccountNumber: ak.GetAccount(ctx, acc).GetAccountNumber(),
+				Sequence:      sk[signer.Did],
+				Msgs:          msgs,
+			})
+		}
+		return NewIxoTx(msgs, auth.StdFee{}, []IxoSignature{
+			signMsg(signerA, accA.GetAddress()),
+			signMsg(signerB, accB.GetAddress()),
+		}, "")
+	}
+
+	msgA := testRouteMsg{testIxoMsg{signerA.Did}, "alpha", "send"}
+	msgB := testRouteMsg{testIxoMsg{signerB.Did}, "beta", "send"}
+
+	// a tx that batches msgs of both modules is verified with both resolvers
+	_, err = anteHandler(ctx, signedTx(msgA, msgB), false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), sk[signerA.Did])
+	require.Equal(t, uint64(1), sk[signerB.Did])
+
+	// the resolver of a msg is chosen by its route
+	swapped := testRouteMsg{testIxoMsg{signerA.Did}, "beta", "send"}
+	_, err = anteHandler(ctx, signedTx(swapped, msgB), false)
+	require.NotNil(t, err)
+
+	// a msg of a module that registered no resolver is rejected
+	unrouted := testRouteMsg{testIxoMsg{signerB.Did}, "gamma", "send"}
+	_, err = anteHandler(ctx, signedTx(msgA, unrouted), false)
+	require.NotNil(t, err)
+	require.Equal(t, uint64(1), sk[signerA.Did])
+
+	// a StdTx is verified by the StdTx ante handler
+	_, err = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msgA}, auth.StdFee{}, nil, ""), false)
+	require.Nil(t, err)
+	require.Equal(t, 1, stdTxs)
+}
+
+func TestRouterAnteHandlerMsgTypeHandler(t *testing.T) {
+	ctx, _ := createTestAccountKeeper()
+	getter := func(sdk.Context, IxoMsg) (crypto.PubKey, error) { return nil, nil }
+
+	var ixoTxs, creations int
+	router := NewSignerRouter().
+		AddRoute("alpha", getter).
+		AddAnteHandler("alpha", "create", func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			creations++
+			return ctx, nil
+		}).
+		AddRoute("beta", getter)
+	ixoTxAnteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ixoTxs++
+		return ctx, nil
+	}
+	anteHandler := NewRouterAnteHandler(router, ixoTxAnteHandler, nil)
+
+	did := exported.NewDidGeneratorBuilder().Build().Did
+	create := testRouteMsg{testIxoMsg{did}, "alpha", "create"}
+	send := testRouteMsg{testIxoMsg{did}, "beta", "send"}
+	sig := NewSignature(time.Now(), []byte("signature"))
+
+	_, err := anteHandler(ctx, NewIxoTx([]sdk.Msg{create}, auth.StdFee{}, []IxoSignature{sig}, ""), false)
+	require.Nil(t, err)
+	require.Equal(t, 1, creations)
+	require.Equal(t, 0, ixoTxs)
+
+	_, err = anteHandler(ctx, NewIxoTx([]sdk.Msg{send}, auth.StdFee{}, []IxoSignature{sig}, ""), false)
+	require.Nil(t, err)
+	require.Equal(t, 1, ixoTxs)
+
+	// a msg with its own ante handler cannot be batched
+	_, err = anteHandler(ctx, NewIxoTx([]sdk.Msg{send, create}, auth.StdFee{}, []IxoSignature{sig}, ""), false)
+	require.NotNil(t, err)
+	require.Equal(t, 1, creations)
+	require.Equal(t, 1, ixoTxs)
+
+	// a msg type handler needs the route to be registered first
+	require.Panics(t, func() { router.AddAnteHandler("gamma", "create", ixoTxAnteHandler) })
+	require.Panics(t, func() { router.AddRoute("beta", getter) })
+}
+
+// testAddDidMsg is a testIxoMsg that sets the key of its signer DID
+type testAddDidMsg struct {
+	testIxoMsg
+	verifyKey string
+}
+
+func (msg testAddDidMsg) Route() string { return "did" }
+func (msg testAddDidMsg) Type() string  { return "add-did" }
+
+func TestRouterAnteHandlerBatchedKeyTakeover(t *testing.T) {
+	ctx, ak := createTestAccountKeeper()
+	sk := testSequenceKeeper{}
+	project := exported.NewDidGeneratorBuilder().Build()
+	attacker := exported.NewDidGeneratorBuilder().Build()
+
+	// the did module resolves a new DID to the key in the msg, the project
+	// module resolves the project to its stored key
+	router := NewSignerRouter().
+		AddRoute("did", func(_ sdk.Context, msg IxoMsg) (crypto.PubKey, error) {
+			return exported.VerifyKeyToPublicKeyEd25519(msg.(testAddDidMsg).verifyKey), nil
+		}).
+		AddRoute("project", func(sdk.Context, IxoMsg) (crypto.PubKey, error) {
+			return exported.VerifyKeyToPublicKeyEd25519(project.VerifyKey), nil
+		})
+	ixoTxAnteHandler := sdk.ChainAnteDecorators(NewSigVerificationAndIncrementSequenceDecorator(ak, sk, router.PubKeyGetter()))
+	anteHandler := NewRouterAnteHandler(router, ixoTxAnteHandler, nil)
+
+	attackerAcc := ak.NewAccountWithAddress(ctx, attacker.Address())
+	ak.SetAccount(ctx, attackerAcc)
+	projectAcc := ak.NewAccountWithAddress(ctx, project.Address())
+	ak.SetAccount(ctx, projectAcc)
+
+	signedTx := func(signer exported.IxoDid, acc sdk.AccAddress, msgs ...sdk.Msg) IxoTx {
+		tx, err := SignStdSignMsg(signer, auth.StdSignMsg{
+			ChainID:       testChainID,
+			AccountNumber: ak.GetAccount(ctx, acc).GetAccountNumber(),
+			Sequence:      sk[project.Did],
+			Msgs:          msgs,
+		}, "")
+		require.Nil(t, err)
+		return tx
+	}
+
+	// a msg that sets the attacker's key on the project DID does not lend
+	// that key to the project msg batched after it
+	addDid := testAddDidMsg{testIxoMsg{project.Did}, attacker.VerifyKey}
+	projectMsg := testRouteMsg{testIxoMsg{project.Did}, "project", "update"}
+	_, err := anteHandler(ctx, signedTx(attacker, attackerAcc.GetAddress(), addDid, projectMsg), false)
+	require.NotNil(t, err)
+	require.Equal(t, uint64(0), sk[project.Did])
+
+	// msgs that resolve to the same key are accepted
+	addDid = testAddDidMsg{testIxoMsg{project.Did}, project.VerifyKey}
+	_, err = anteHandler(ctx, signedTx(project, projectAcc.GetAddress(), addDid, projectMsg), false)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), sk[project.Did])
+}
@@ -46,8 +46,15 @@ type (
 		GetSignerDid() exported.Did
 	}
 	IxoSignature struct {
-		SignatureValue []byte    `json:"signatureValue" yaml:"signatureValue"`
-		Created        time.Time `json:"created" yaml:"created"`
+		SignatureValue []byte            `json:"signatureValue" yaml:"signatureValue"`
+		Created        time.Time         `json:"created" yaml:"created"`
+		Controller     exported.Did      `json:"controller,omitempty" yaml:"controller"`         // set when a controller signs on behalf of the signer
+		SubSignatures  []IxoSubSignature `json:"subSignatures,omitempty" yaml:"subSignatures"` // set instead of SignatureValue for a multisig key set
+	}
+	// IxoSubSignature is the signature of one key of a multisig key set
+	IxoSubSignature struct {
+		PubKey         string `json:"pubKey" yaml:"pubKey"` // base58 verify key
+		SignatureValue []byte `json:"signatureValue" yaml:"signatureValue"`
 	}
 
 	IxoTx struct {
@@ -55,6 +62,7 @@ type (
 		Fee        auth.StdFee    `json:"fee" yaml:"fee"`
 		Signatures []IxoSignature `json:"signatures" yaml:"signatures"`
 		Memo       string         `json:"memo,omitempty" yaml:"memo"`
+		FeeGranter exported.Did   `json:"feeGranter,omitempty" yaml:"feeGranter"` // set when a fee allowance pays the fee
 	}
 
 	TxActor interface {
@@ -65,7 +73,7 @@ type (
 		GetGas() uint64
 		GetFee() sdk.Coins
 		FeePayer() sdk.AccAddress
-		GetSignBytes(ctx sdk.Context, acc authexported.Account) []byte
+		GetSignBytes(ctx sdk.Context, acc authexported.Account, sequence uint64) []byte
 		GetSigner() sdk.AccAddress
 		GetSignatures() []IxoSignature
 		GetFirstSignature() []byte
@@ -77,6 +85,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	//cdc.RegisterInterface((*[]interface{})(nil), nil)
 	cdc.RegisterConcrete(IxoTx{}, "darkpool/IxoTx", nil)
 	cdc.RegisterConcrete(IxoSignature{}, "darkpool/IxoSignature", nil)
+	cdc.RegisterConcrete(IxoSubSignature{}, "darkpool/IxoSubSignature", nil)
 
 }
 
@@ -90,9 +99,11 @@ func (is IxoSignature) MarshalYAML() (interface{}, error) {
 	bz, err = yaml.Marshal(struct {
 		SignatureValue string
 		Created        string
+		Controller     string `yaml:",omitempty"`
 	}{
 		SignatureValue: fmt.Sprintf("%s", is.SignatureValue),
 		Created:        is.Created.String(),
+		Controller:     is.Controller,
 	})
 	if err != nil {
 		return nil, err
@@ -102,7 +113,7 @@ func (is IxoSignature) MarshalYAML() (interface{}, error) {
 }
 
 func (is IxoSignature) String() string {
-	return fmt.Sprintf("{%V}", is)
+	return fmt.Sprintf("{%X %v %s}", is.SignatureValue, is.Created, is.Controller)
 }
 
 /*
@@ -142,6 +153,14 @@ func NewSignature(created time.Time, signature []byte) IxoSignature {
 		Created:        created,
 	}
 }
+// NewMultisigSignature combines the sub-signatures of the keys of a multisig
+// key set into a single signature
+func NewMultisigSignature(created time.Time, subSignatures []IxoSubSignature) IxoSignature {
+	return IxoSignature{
+		Created:       created,
+		SubSignatures: subSignatures,
+	}
+}
 func NewIxoTxSingleMsg(msg sdk.Msg, fee auth.StdFee, signature IxoSignature, memo string) IxoTx {
 	return NewIxoTx([]sdk.Msg{msg}, fee, []IxoSignature{signature}, memo)
 }
@@ -157,21 +176,61 @@ func (tx IxoTx) ValidateBasic() error {
 		//return sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee %s amount provided", tx.Fee.Amount))
 		return errors.Wrapf(errors.ErrInsufficientFee, "invalid fee %s amount provided", tx.Fee.Amount)
 	}
+	// Messages validation
+	if len(tx.Msgs) == 0 {
+		return errors.Wrap(errors.ErrUnauthorized, "there must be at least one message. dxp")
+	}
+	signerMsgs, err := tx.getSignerMsgs()
+	if err != nil {
+		return err
+	}
 	// Signatures validation
 	var ixoSigs = tx.GetSignatures()
 	if len(ixoSigs) == 0 {
 		return errors.Wrap(errors.ErrNoSignatures, "no signers. dxp")
 	}
-	if len(ixoSigs) != 1 {
-		return errors.Wrap(errors.ErrUnauthorized, "there can only be one signer. dxp")
+	if len(ixoSigs) != len(signerMsgs) {
+		return errors.Wrapf(errors.ErrUnauthorized, "wrong number of signers; expected %d, got %d. dxp", len(signerMsgs), len(ixoSigs))
 	}
-	// Messages validation
-	if len(tx.Msgs) != 1 {
-		return errors.Wrap(errors.ErrUnauthorized, "there can only be one message. dxp")
+	for _, sig := range ixoSigs {
+		if len(sig.SubSignatures) > exported.MaxMultisigKeys {
+			return errors.Wrapf(errors.ErrTooManySignatures, "signature has more than %d sub-signatures", exported.MaxMultisigKeys)
+		}
+	}
+	if tx.FeeGranter != "" && !exported.IsValidDid(tx.FeeGranter) {
+		return errors.Wrapf(exported.ErrorInvalidDidE, "invalid fee granter %s", tx.FeeGranter)
 	}
 
 	return nil
 }
+
+// GetSignerDids returns the DIDs that must sign the tx, in the order in which
+// each of them first signs a message. Signatures are expected in this order.
+func (tx IxoTx) GetSignerDids() []exported.Did {
+	signerMsgs, _ := tx.getSignerMsgs()
+	dids := make([]exported.Did, len(signerMsgs))
+	for i, msg := range signerMsgs {
+		dids[i] = msg.GetSignerDid()
+	}
+	return dids
+}
+
+// getSignerMsgs returns the first message signed by each signer DID
+func (tx IxoTx) getSignerMsgs() ([]IxoMsg, error) {
+	var signerMsgs []IxoMsg
+	seen := make(map[exported.Did]bool)
+	for _, msg := range tx.Msgs {
+		ixoMsg, ok := msg.(IxoMsg)
+		if !ok {
+			return nil, IntErr("msg must be ixo.IxoMsg. dxp")
+		}
+		if !seen[ixoMsg.GetSignerDid()] {
+			seen[ixoMsg.GetSignerDid()] = true
+			signerMsgs = append(signerMsgs, ixoMsg)
+		}
+	}
+	return signerMsgs, nil
+}
 func (tx IxoTx) String() string {
 	output, err := json.MarshalIndent(tx, "", "  ")
 	if err != nil {
@@ -198,18 +257,46 @@ func NewIxoTx(msgs []sdk.Msg, fee auth.StdFee, sigs []IxoSignature, memo string)
 	}
 }
 
-//used on verification
-func (tx IxoTx) GetSignBytes(ctx sdk.Context, acc authexported.Account) []byte {
+// GetSignBytes returns the bytes signed by a signer of the tx. They bind the
+// signature to the chain, the signing account and the sequence of the signer
+// DID, which is the nonce that makes the tx valid only once.
+func (tx IxoTx) GetSignBytes(ctx sdk.Context, acc authexported.Account, sequence uint64) []byte {
 	genesis := ctx.BlockHeight() == 0
 	chainID := ctx.ChainID()
 	var accNum uint64
 	if !genesis {
 		accNum = acc.GetAccountNumber()
 	}
-	signByte := auth.StdSignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
-	)
-	return signByte
+	return tx.signBytes(chainID, accNum, sequence)
+}
+
+func (tx IxoTx) signBytes(chainID string, accNum uint64, sequence uint64) []byte {
+	return IxoSignBytes(chainID, accNum, sequence, tx.Fee, tx.Msgs, tx.Memo, tx.FeeGranter)
+}
+
+// IxoSignBytes returns the StdSignBytes of a tx. A tx that names a fee granter
+// also signs the granter, so that it cannot be swapped for another one.
+func IxoSignBytes(chainID string, accNum uint64, sequence uint64, fee auth.StdFee, msgs []sdk.Msg, memo string, feeGranter exported.Did) []byte {
+	bz := auth.StdSignBytes(chainID, accNum, sequence, fee, msgs, memo)
+	if feeGranter == "" {
+		return bz
+	}
+
+	var signDoc map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &signDoc); err != nil {
+		panic(err)
+	}
+	granter, err := json.Marshal(feeGranter)
+	if err != nil {
+		panic(err)
+	}
+	signDoc["fee_granter"] = granter
+
+	bz, err = json.Marshal(signDoc)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
 }
 func (tx IxoTx) GetSigner() sdk.AccAddress {
 	return tx.GetMsgs()[0].GetSigners()[0]
@@ -294,53 +381,192 @@ func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 }
 
 type SignTxPack struct {
-	ctxCli context.CLIContext
-	msg    sdk.Msg
-	did    exported.IxoDid
-	txBldr auth.TxBuilder
+	ctxCli     context.CLIContext
+	msgs       []sdk.Msg
+	dids       []exported.IxoDid
+	txBldr     auth.TxBuilder
+	feeGranter exported.Did
 }
 
 func NewDidTxBuild(ctx context.CLIContext, msg sdk.Msg, ixoDid exported.IxoDid) SignTxPack {
+	return NewDidMultiMsgTxBuild(ctx, []sdk.Msg{msg}, []exported.IxoDid{ixoDid})
+}
+
+// NewDidMultiMsgTxBuild prepares a tx with several messages. One DID document
+// must be given for every signer DID of the messages, in the order of
+// GetSignerDids; the first one pays the fees and is taken from the context.
+func NewDidMultiMsgTxBuild(ctx context.CLIContext, msgs []sdk.Msg, ixoDids []exported.IxoDid) SignTxPack {
 	instance := SignTxPack{
-		ctxCli: ctx,
-		msg:    msg,
-		did:    ixoDid,
+		ctxCli:     ctx,
+		msgs:       msgs,
+		dids:       ixoDids,
+		feeGranter: viper.GetString(FlagFeeGranter),
 	}
 	instance.txBldr = auth.NewTxBuilderFromCLI(ctx.Input)
 	return instance
 }
 
 func (tb SignTxPack) collectMsgs() []sdk.Msg {
-	return []sdk.Msg{tb.msg}
+	return tb.msgs
 }
 
-func (tb SignTxPack) collectSignatures(sig IxoSignature) []IxoSignature {
-	return []IxoSignature{sig}
+// collectSignatures signs the message once for every signer DID. The first
+// signer uses the account of the sign message, the others their own account.
+func (tb SignTxPack) collectSignatures(standardMsg auth.StdSignMsg) ([]IxoSignature, error) {
+	signerDids := NewIxoTx(tb.msgs, standardMsg.Fee, nil, standardMsg.Memo).GetSignerDids()
+	if len(signerDids) != len(tb.dids) {
+		return nil, fmt.Errorf("expected %d signer did docs but got %d", len(signerDids), len(tb.dids))
+	}
+
+	accGetter := auth.NewAccountRetriever(tb.ctxCli)
+	signatures := make([]IxoSignature, len(tb.dids))
+	for i, ixoDid := range tb.dids {
+		signMsg := standardMsg
+		if i > 0 {
+			accNum, seq, err := tb.accountNumberSequence(accGetter, ixoDid.Address())
+			if err != nil {
+				return nil, err
+			}
+			seq, err = QueryDidSequence(tb.ctxCli, signerDids[i], seq)
+			if err != nil {
+				return nil, err
+			}
+			signMsg.AccountNumber, signMsg.Sequence = accNum, seq
+		}
+
+		signatures[i] = signForSignature(ixoDid, ixoSignMsgBytes(signMsg, tb.feeGranter))
+		// signing with a DID other than the message signer is done as its controller
+		if signerDids[i] != ixoDid.Did {
+			signatures[i].Controller = ixoDid.Did
+		}
+	}
+	return signatures, nil
+}
+
+// accountNumberSequence returns the account number and sequence of a signer.
+// The account of a new did paid for by a fee granter does not exist yet and
+// is signed for with account number 0.
+func (tb SignTxPack) accountNumberSequence(accGetter auth.AccountRetriever, address sdk.AccAddress) (uint64, uint64, error) {
+	if tb.feeGranter != "" && accGetter.EnsureExists(address) != nil {
+		return 0, 0, nil
+	}
+	return accGetter.GetAccountNumberSequence(address)
+}
+
+// prepareTxBuilder fills in the account number and sequence of the first signer
+func (tb SignTxPack) prepareTxBuilder() (auth.TxBuilder, error) {
+	if tb.feeGranter != "" && auth.NewAccountRetriever(tb.ctxCli).EnsureExists(tb.ctxCli.GetFromAddress()) != nil {
+		return tb.txBldr, nil
+	}
+	return utils.PrepareTxBuilder(tb.txBldr, tb.ctxCli)
+}
+
+func ixoSignMsgBytes(msg auth.StdSignMsg, feeGranter exported.Did) []byte {
+	return IxoSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo, feeGranter)
 }
 
 // sign the message in here
-func (tb SignTxPack) SignMsgForSignature(msg auth.StdSignMsg) IxoSignature {
-	privateKey := tb.did.GetPriKeyByte()
+func SignMsgForSignature(ixoDid exported.IxoDid, msg auth.StdSignMsg) IxoSignature {
+	return signForSignature(ixoDid, msg.Bytes())
+}
+
+func signForSignature(ixoDid exported.IxoDid, signBytes []byte) IxoSignature {
+	if ixoDid.GetKeyType() == exported.KeyTypeSecp256k1 {
+		signatureBytes, err := ixoDid.SignMessage(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		return NewSignature(time.Now(), signatureBytes)
+	}
+
+	privateKey := ixoDid.GetPriKeyByte()
 
 	if l := len(privateKey); l != ed25519.PrivateKeySize {
 		panic("ed25519: bad private key length: " + strconv.Itoa(l))
 	}
 
-	signatureBytes := ed25519.Sign(privateKey[:], msg.Bytes())
-	fmt.Println("===> ⚠️ check signed message =====")
-	fmt.Println(msg.Bytes())
+	signatureBytes := ed25519.Sign(privateKey[:], signBytes)
 
 	//return NewSignature(time.Now(), signatureBytes[:])
 	return NewSignature(time.Now(), signatureBytes)
 }
 
-func (tb SignTxPack) CollectSignedMessage(standardMsg auth.StdSignMsg) IxoTx {
-	signature := tb.SignMsgForSignature(standardMsg)
+// SignMsgForSubSignature signs the message with one key of a multisig key set
+func SignMsgForSubSignature(ixoDid exported.IxoDid, msg auth.StdSignMsg) IxoSubSignature {
+	return IxoSubSignature{
+		PubKey:         ixoDid.GetPubKey(),
+		SignatureValue: SignMsgForSignature(ixoDid, msg).SignatureValue,
+	}
+}
+
+func (tb SignTxPack) CollectSignedMessage(standardMsg auth.StdSignMsg) (IxoTx, error) {
 	//sign message
-	signingSignature := tb.collectSignatures(signature)
+	signingSignatures, err := tb.collectSignatures(standardMsg)
+	if err != nil {
+		return IxoTx{}, err
+	}
 	//collection of messages
 	messages := tb.collectMsgs()
-	return NewIxoTx(messages, standardMsg.Fee, signingSignature, standardMsg.Memo)
+	tx := NewIxoTx(messages, standardMsg.Fee, signingSignatures, standardMsg.Memo)
+	tx.FeeGranter = tb.feeGranter
+	return tx, nil
+}
+
+// QueryDidSequence returns the sequence that a DID signs its next tx with.
+// A DID that has not signed since sequences are stored per DID signs with the
+// sequence of its account, which is given as accSequence.
+func QueryDidSequence(ctx context.CLIContext, did exported.Did, accSequence uint64) (uint64, error) {
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", didQuerierRoute, queryDidSequence, did), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var didSequence *exported.DidSequence
+	if err := ctx.Codec.UnmarshalJSON(res, &didSequence); err != nil {
+		return 0, err
+	}
+	if didSequence == nil {
+		return accSequence, nil
+	}
+	return didSequence.Sequence, nil
+}
+
+// SignStdSignMsg signs an unsigned tx offline, e.g. one printed with
+// --generate-only, with the DID document of its signer or of a controller of
+// the signer. The sign message carries the account number and sequence. The
+// fee granter is optional.
+func SignStdSignMsg(ixoDid exported.IxoDid, signMsg auth.StdSignMsg, feeGranter exported.Did) (IxoTx, error) {
+	signerDids := NewIxoTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo).GetSignerDids()
+	if len(signerDids) != 1 {
+		return IxoTx{}, fmt.Errorf("offline signing requires a single signer did but got %d", len(signerDids))
+	}
+
+	signature := signForSignature(ixoDid, ixoSignMsgBytes(signMsg, feeGranter))
+	if signerDids[0] != ixoDid.Did {
+		signature.Controller = ixoDid.Did
+	}
+
+	tx := NewIxoTx(signMsg.Msgs, signMsg.Fee, []IxoSignature{signature}, signMsg.Memo)
+	tx.FeeGranter = feeGranter
+	if err := tx.ValidateBasic(); err != nil {
+		return IxoTx{}, err
+	}
+	return tx, nil
+}
+
+// BroadcastIxoTx broadcasts a signed tx in its JSON encoding, which is what
+// DefaultTxDecoder recognizes as an IxoTx
+func BroadcastIxoTx(ctx context.CLIContext, tx IxoTx) (sdk.TxResponse, error) {
+	bz, err := ctx.Codec.MarshalJSON(tx)
+	if err != nil {
+		return sdk.TxResponse{}, fmt.Errorf("Could not marshall tx to binary. Error: %s! ", err.Error())
+	}
+
+	res, err := ctx.BroadcastTx(bz)
+	if err != nil {
+		return sdk.TxResponse{}, fmt.Errorf("Could not broadcast tx. Error: %s! ", err.Error())
+	}
+	return res, nil
 }
 
 func (tb SignTxPack) printUnsignedStdTx(stdSignMsg auth.StdSignMsg) error {
@@ -369,24 +595,53 @@ func (tb SignTxPack) printUnsignedStdTx(stdSignMsg auth.StdSignMsg) error {
 }
 func (tb SignTxPack) doSimulate() error {
 	if tb.ctxCli.Simulate {
-		txBldr, err := utils.EnrichWithGas(tb.txBldr, tb.ctxCli, tb.collectMsgs())
+		tx := NewIxoTx(tb.collectMsgs(), auth.StdFee{}, nil, tb.txBldr.Memo())
+		tx.FeeGranter = tb.feeGranter
+		_, gas, err := SimulateIxoTx(tb.ctxCli, tx, tb.txBldr.GasAdjustment())
 		if err != nil {
 			return err
 		}
 
-		gasEst := utils.GasEstimateResponse{GasEstimate: txBldr.Gas()}
+		gasEst := utils.GasEstimateResponse{GasEstimate: gas}
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 	return nil
 }
+
+// SimulateIxoTx runs an unsigned tx through the simulate query of the node and
+// returns the simulation result and the gas it used times the adjustment. The
+// tx is sent JSON encoded so that DefaultTxDecoder recognizes it as an IxoTx.
+func SimulateIxoTx(ctx context.CLIContext, tx IxoTx, adjustment float64) (sdk.SimulationResponse, uint64, error) {
+	// signatures are not verified in simulate mode, but one is expected for
+	// each signer
+	if len(tx.Signatures) == 0 {
+		tx.Signatures = make([]IxoSignature, len(tx.GetSignerDids()))
+	}
+
+	bz, err := ctx.Codec.MarshalJSON(tx)
+	if err != nil {
+		return sdk.SimulationResponse{}, 0, err
+	}
+	return utils.CalculateGas(ctx.QueryWithData, ctx.Codec, bz, adjustment)
+}
 func (tb SignTxPack) DebugTxDecode() error {
 
-	txBldr, err := utils.PrepareTxBuilder(tb.txBldr, tb.ctxCli)
+	txBldr, err := tb.prepareTxBuilder()
 	if err != nil {
 		return err
 	}
 
-	stdSignMsg, err := txBldr.BuildSignMsg([]sdk.Msg{tb.msg})
+	// the first signer signs with the sequence of its did unless one is given
+	if viper.GetUint64(flags.FlagSequence) == 0 {
+		signerDid := NewIxoTx(tb.msgs, auth.StdFee{}, nil, "").GetSignerDids()[0]
+		sequence, err := QueryDidSequence(tb.ctxCli, signerDid, txBldr.Sequence())
+		if err != nil {
+			return err
+		}
+		txBldr = txBldr.WithSequence(sequence)
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg(tb.collectMsgs())
 	if err != nil {
 		return err
 	}
@@ -398,7 +653,10 @@ func (tb SignTxPack) DebugTxDecode() error {
 		return nil
 	}
 	//will print the message  check signed message ==
-	signTxMsg := tb.CollectSignedMessage(stdSignMsg)
+	signTxMsg, err := tb.CollectSignedMessage(stdSignMsg)
+	if err != nil {
+		return err
+	}
 	bz, err := tb.ctxCli.Codec.MarshalJSON(signTxMsg)
 	if err != nil {
 		return fmt.Errorf("Could not marshall tx to binary. Error: %s! ", err.Error())
@@ -417,17 +675,29 @@ func (tb SignTxPack) DebugTxDecode() error {
 }
 func (tb SignTxPack) CompleteAndBroadcastTxCLI() error {
 
-	txBldr, err := utils.PrepareTxBuilder(tb.txBldr, tb.ctxCli)
+	txBldr, err := tb.prepareTxBuilder()
 	if err != nil {
 		return err
 	}
 
-	stdSignMsg, err := txBldr.BuildSignMsg([]sdk.Msg{tb.msg})
+	// the first signer signs with the sequence of its did unless one is given
+	if viper.GetUint64(flags.FlagSequence) == 0 {
+		signerDid := NewIxoTx(tb.msgs, auth.StdFee{}, nil, "").GetSignerDids()[0]
+		sequence, err := QueryDidSequence(tb.ctxCli, signerDid, txBldr.Sequence())
+		if err != nil {
+			return err
+		}
+		txBldr = txBldr.WithSequence(sequence)
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg(tb.collectMsgs())
 	if err != nil {
 		return err
 	}
 
-	if tb.ctxCli.Simulate {
+	// the unsigned message is printed to be signed offline, e.g. by the keys
+	// of a multisig key set
+	if tb.ctxCli.Simulate || tb.ctxCli.GenerateOnly {
 		if err := tb.printUnsignedStdTx(stdSignMsg); err != nil {
 			return err
 		}
@@ -464,19 +734,17 @@ func (tb SignTxPack) CompleteAndBroadcastTxCLI() error {
 		}
 	}
 	//will print the message  check signed message ==
-	signTxMsg := tb.CollectSignedMessage(stdSignMsg)
+	signTxMsg, err := tb.CollectSignedMessage(stdSignMsg)
+	if err != nil {
+		return err
+	}
 	/*
 		fmt.Println("=============== pre-tx-signature ==============")
 		fmt.Println(signTxMsg.GetFirstSignature())
 	*/
-	bz, err := tb.ctxCli.Codec.MarshalJSON(signTxMsg)
+	res, err := BroadcastIxoTx(tb.ctxCli, signTxMsg)
 	if err != nil {
-		return fmt.Errorf("Could not marshall tx to binary. Error: %s! ", err.Error())
-	}
-
-	res, err := tb.ctxCli.BroadcastTx(bz)
-	if err != nil {
-		return fmt.Errorf("Could not broadcast tx. Error: %s! ", err.Error())
+		return err
 	}
 
 	fmt.Println(res.String())
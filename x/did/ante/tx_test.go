@@ -0,0 +1,151 @@
+package ante
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+type testIxoMsg struct {
+	signerDid exported.Did
+}
+
+func (msg testIxoMsg) Route() string        { return "test" }
+func (msg testIxoMsg) Type() string         { return "test" }
+func (msg testIxoMsg) ValidateBasic() error { return nil }
+func (msg testIxoMsg) GetSignBytes() []byte { return []byte(`"` + msg.signerDid + `"`) }
+func (msg testIxoMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{DidToAddr(msg.signerDid)}
+}
+func (msg testIxoMsg) GetSignerDid() exported.Did { return msg.signerDid }
+
+func TestIxoTxSignerDids(t *testing.T) {
+	didA := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	didB := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+	msgs := []sdk.Msg{testIxoMsg{didA}, testIxoMsg{didB}, testIxoMsg{didA}}
+	sig := NewSignature(time.Now(), []byte("signature"))
+
+	tx := NewIxoTx(msgs, auth.StdFee{}, []IxoSignature{sig, sig}, "")
+	require.Equal(t, []exported.Did{didA, didB}, tx.GetSignerDids())
+	require.Nil(t, tx.ValidateBasic())
+
+	// one signature per signer did
+	tx = NewIxoTx(msgs, auth.StdFee{}, []IxoSignature{sig}, "")
+	require.NotNil(t, tx.ValidateBasic())
+	tx = NewIxoTx(msgs, auth.StdFee{}, []IxoSignature{sig, sig, sig}, "")
+	require.NotNil(t, tx.ValidateBasic())
+
+	// at least one message
+	tx = NewIxoTx(nil, auth.StdFee{}, []IxoSignature{sig}, "")
+	require.NotNil(t, tx.ValidateBasic())
+}
+
+func TestVerifyMultisig(t *testing.T) {
+	privKeys := []ed25519tm.PrivKeyEd25519{ed25519tm.GenPrivKey(), ed25519tm.GenPrivKey(), ed25519tm.GenPrivKey()}
+	pubKeys := make([]string, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKey := privKey.PubKey().(ed25519tm.PubKeyEd25519)
+		pubKeys[i] = base58.Encode(pubKey[:])
+	}
+	keySet := exported.NewMultisigKeySet(2, pubKeys)
+	require.Nil(t, keySet.Validate())
+	key := keySet.PubKey().(multisig.PubKeyMultisigThreshold)
+
+	message := []byte("message")
+	sign := func(i int) IxoSubSignature {
+		sig, err := privKeys[i].Sign(message)
+		require.Nil(t, err)
+		return IxoSubSignature{PubKey: pubKeys[i], SignatureValue: sig}
+	}
+
+	sv := SigVerificationDecorator{}
+	require.Nil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(0), sign(2)}))
+	require.Nil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(0), sign(1), sign(2)}))
+
+	// below threshold
+	require.NotNil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(1)}))
+	// the same key twice
+	require.NotNil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(1), sign(1)}))
+	// a key outside of the set
+	other := ed25519tm.GenPrivKey()
+	otherPub := other.PubKey().(ed25519tm.PubKeyEd25519)
+	otherSig, _ := other.Sign(message)
+	outsider := IxoSubSignature{PubKey: base58.Encode(otherPub[:]), SignatureValue: otherSig}
+	require.NotNil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(0), outsider}))
+	// a signature over another message
+	bad := sign(2)
+	bad.SignatureValue, _ = privKeys[2].Sign([]byte("other"))
+	require.NotNil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{sign(0), bad}))
+}
+
+func TestVerifyMultisigMixedKeys(t *testing.T) {
+	edPriv := ed25519tm.GenPrivKey()
+	edPub := edPriv.PubKey().(ed25519tm.PubKeyEd25519)
+	secpPriv := secp256k1.GenPrivKey()
+	secpPub := secpPriv.PubKey().(secp256k1.PubKeySecp256k1)
+	pubKeys := []string{base58.Encode(edPub[:]), base58.Encode(secpPub[:])}
+	keySet := exported.NewMultisigKeySet(2, pubKeys)
+	require.Nil(t, keySet.Validate())
+	key := keySet.PubKey().(multisig.PubKeyMultisigThreshold)
+
+	message := []byte("message")
+	edSig, err := edPriv.Sign(message)
+	require.Nil(t, err)
+	secpSig, err := secpPriv.Sign(message)
+	require.Nil(t, err)
+
+	sv := SigVerificationDecorator{}
+	require.Nil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{
+		{PubKey: pubKeys[0], SignatureValue: edSig},
+		{PubKey: pubKeys[1], SignatureValue: secpSig},
+	}))
+
+	// a secp256k1 signature over another message
+	secpSig, _ = secpPriv.Sign([]byte("other"))
+	require.NotNil(t, sv.VerifyMultisig(key, message, []IxoSubSignature{
+		{PubKey: pubKeys[0], SignatureValue: edSig},
+		{PubKey: pubKeys[1], SignatureValue: secpSig},
+	}))
+}
+
+func TestSignStdSignMsg(t *testing.T) {
+	signer := exported.NewDidGeneratorBuilder().Build()
+	controller := exported.NewDidGeneratorBuilder().Build()
+
+	signMsg := auth.StdSignMsg{
+		ChainID:       "test",
+		AccountNumber: 3,
+		Sequence:      7,
+		Msgs:          []sdk.Msg{testIxoMsg{signer.Did}},
+	}
+
+	tx, err := SignStdSignMsg(signer, signMsg, "")
+	require.Nil(t, err)
+	require.Len(t, tx.GetSignatures(), 1)
+	require.Empty(t, tx.GetSignatures()[0].Controller)
+
+	sv := SigVerificationDecorator{}
+	pub := base58.Decode(signer.VerifyKey)
+	require.Nil(t, sv.VerifyNow(pub, signMsg.Bytes(), tx.GetFirstSignature()))
+	// the signature is bound to the account sequence
+	signMsg.Sequence++
+	require.NotNil(t, sv.VerifyNow(pub, signMsg.Bytes(), tx.GetFirstSignature()))
+
+	tx, err = SignStdSignMsg(controller, signMsg, "")
+	require.Nil(t, err)
+	require.Equal(t, controller.Did, tx.GetSignatures()[0].Controller)
+
+	// one signature cannot cover several signer dids
+	signMsg.Msgs = append(signMsg.Msgs, testIxoMsg{controller.Did})
+	_, err = SignStdSignMsg(signer, signMsg, "")
+	require.NotNil(t, err)
+}
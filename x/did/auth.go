@@ -2,41 +2,101 @@ package did
 
 import (
 	"fmt"
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
-	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tokenchain/dp-hub/x/did/ante"
 	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
 )
 
 func GetPubKeyGetter(keeper Keeper) ante.PubKeyGetter {
 	return func(ctx sdk.Context, msg ante.IxoMsg) (pubKey crypto.PubKey, res error) {
 		// Get signer PubKey
-		var pubKeyEd25519 ed25519tm.PubKeyEd25519
 		switch msg := msg.(type) {
 		case MsgAddDid:
-			copy(pubKeyEd25519[:], base58.Decode(msg.DidDoc.PubKey))
-			//pubKeyEd25519 = did.RecoverDidToEd25519PubKey(msg.DidDoc.)
+			// a new DID has no controllers, so it must sign for itself
+			if _, ok := ante.SignatureController(ctx); ok {
+				return pubKey, exported.Unauthorized("a new did cannot be signed by a controller")
+			}
+			// the key of a new DID signs for it, and so authorises the key set
+			// it declares
+			return ownPubKey(msg.DidDoc)
 
 		default:
 			// For the remaining messages, the did is the signer
 			//fmt.Println("--- GetPubKeyGetter .1")
 			fmt.Println(msg.GetSignerDid())
 
-			didDoc, er := keeper.GetDidDoc(ctx, msg.GetSignerDid())
 			//fmt.Println("--- GetPubKeyGetter .3")
-			if er != nil {
-				return nil, er
-			}
-			//fmt.Println("--- GetPubKeyGetter .4")
-			if didDoc == nil {
-				return pubKey, exported.Unauthorized("Issuer did not found")
-			}
+			return SignerPubKey(ctx, keeper, msg.GetSignerDid())
+		}
+	}
+}
 
-			copy(pubKeyEd25519[:], base58.Decode(didDoc.GetPubKey()))
-			//fmt.Println("--- GetPubKeyGetter .5")
+// SignerPubKey returns the key that must have signed for the DID. This is the
+// key of the DID itself, or the key of one of its controllers if the tx
+// signature was made by a controller. A DID that declares a multisig key set
+// is signed for by the threshold key of the set.
+func SignerPubKey(ctx sdk.Context, keeper Keeper, did exported.Did) (crypto.PubKey, error) {
+	controllerDid, ok := ante.SignatureController(ctx)
+	if !ok {
+		didDoc, err := keeper.GetDidDoc(ctx, did)
+		if err != nil {
+			return nil, err
+		} else if didDoc == nil {
+			return nil, exported.Unauthorized("Issuer did not found")
 		}
-		return pubKeyEd25519, nil
+		return didDocPubKey(didDoc)
+	}
+
+	if !keeper.IsController(ctx, did, controllerDid) {
+		return nil, exported.Unauthorized(fmt.Sprintf("%s is not a controller of %s", controllerDid, did))
+	}
+	controllerDoc, err := keeper.GetDidDoc(ctx, controllerDid)
+	if err != nil {
+		return nil, err
+	}
+	return didDocPubKey(controllerDoc)
+}
+
+// HasKeySet checks if the DID document of a DID declares a multisig key set
+func HasKeySet(ctx sdk.Context, keeper Keeper, did exported.Did) bool {
+	didDoc, err := keeper.GetDidDoc(ctx, did)
+	if err != nil {
+		return false
+	}
+	baseDidDoc, ok := didDoc.(types.BaseDidDoc)
+	return ok && baseDidDoc.KeySet != nil
+}
+
+// HasPubKey checks if the DID document of a DID was registered with the key.
+// Its controllers and key set are then authorised by the holder of the key.
+func HasPubKey(ctx sdk.Context, keeper Keeper, did exported.Did, pubKey crypto.PubKey) bool {
+	didDoc, err := keeper.GetDidDoc(ctx, did)
+	if err != nil || didDoc == nil {
+		return false
+	}
+	ownKey, err := ownPubKey(didDoc)
+	return err == nil && ownKey.Equals(pubKey)
+}
+
+// didDocPubKey returns the key of a DID document, which is of the key type the
+// document declares, or the threshold key of its key set if it declares one
+func didDocPubKey(didDoc exported.DidDoc) (crypto.PubKey, error) {
+	if baseDidDoc, ok := didDoc.(types.BaseDidDoc); ok && baseDidDoc.KeySet != nil {
+		return baseDidDoc.KeySet.PubKey(), nil
+	}
+	return ownPubKey(didDoc)
+}
+
+// ownPubKey returns the key a DID document was registered with
+func ownPubKey(didDoc exported.DidDoc) (crypto.PubKey, error) {
+	baseDidDoc, ok := didDoc.(types.BaseDidDoc)
+	if !ok {
+		return exported.VerifyKeyToPublicKeyEd25519(didDoc.GetPubKey()), nil
+	} else if baseDidDoc.KeyType == "" {
+		// documents from before key types were declared hold ed25519 keys
+		return exported.VerifyKeyToPublicKeyEd25519(baseDidDoc.PubKey), nil
 	}
+	return exported.VerifyKeyToPubKey(baseDidDoc.PubKey, baseDidDoc.KeyType)
 }
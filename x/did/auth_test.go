@@ -0,0 +1,101 @@
+package did
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/keeper"
+)
+
+func addTestDids(t *testing.T, ctx sdk.Context, k Keeper, ids ...exported.IxoDid) {
+	handler := NewHandler(k)
+	for _, id := range ids {
+		_, err := handler(ctx, NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType))
+		require.Nil(t, err)
+	}
+}
+
+func testPubKey(t *testing.T, id exported.IxoDid) crypto.PubKey {
+	pubKey, err := id.VerifyPubKey()
+	require.Nil(t, err)
+	return pubKey
+}
+
+func TestSignerPubKey(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewHandler(k)
+	subject := exported.NewDidGeneratorBuilder().Build()
+	controller := exported.NewDidGeneratorBuilder().WithKeyType(exported.KeyTypeSecp256k1).Build()
+	addTestDids(t, ctx, k, subject, controller)
+
+	// a DID signs for itself
+	pubKey, err := SignerPubKey(ctx, k, subject.Did)
+	require.Nil(t, err)
+	require.Equal(t, testPubKey(t, subject), pubKey)
+
+	// a DID that is not registered has no key
+	_, err = SignerPubKey(ctx, k, exported.NewDidGeneratorBuilder().Build().Did)
+	require.NotNil(t, err)
+
+	// a DID that is not a controller cannot sign for it
+	controlled := ante.WithSignatureController(ctx, ante.IxoSignature{Controller: controller.Did})
+	_, err = SignerPubKey(controlled, k, subject.Did)
+	require.NotNil(t, err)
+
+	// a controller signs with its own key
+	_, err = handler(ctx, NewMsgAddController(subject.Did, controller.Did))
+	require.Nil(t, err)
+	pubKey, err = SignerPubKey(controlled, k, subject.Did)
+	require.Nil(t, err)
+	require.Equal(t, testPubKey(t, controller), pubKey)
+
+	// a key set signs instead of the key of the DID
+	keySet := exported.NewMultisigKeySet(1, []string{subject.VerifyKey, controller.VerifyKey})
+	_, err = handler(ctx, NewMsgSetKeySet(subject.Did, &keySet))
+	require.Nil(t, err)
+	pubKey, err = SignerPubKey(ctx, k, subject.Did)
+	require.Nil(t, err)
+	require.Equal(t, keySet.PubKey(), pubKey)
+}
+
+func TestPubKeyGetterAddDid(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	getter := GetPubKeyGetter(k)
+	subject := exported.NewDidGeneratorBuilder().Build()
+	other := exported.NewDidGeneratorBuilder().Build()
+
+	// a new DID signs with its own key, also when it declares a key set
+	keySet := exported.NewMultisigKeySet(1, []string{other.VerifyKey})
+	msg := NewMsgAddDid(subject.Did, subject.VerifyKey, subject.KeyType)
+	msg.DidDoc.KeySet = &keySet
+	pubKey, err := getter(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, testPubKey(t, subject), pubKey)
+
+	// and cannot be signed by a controller
+	controlled := ante.WithSignatureController(ctx, ante.IxoSignature{Controller: other.Did})
+	_, err = getter(controlled, msg)
+	require.NotNil(t, err)
+}
+
+func TestHasPubKey(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	subject := exported.NewDidGeneratorBuilder().Build()
+	other := exported.NewDidGeneratorBuilder().Build()
+	addTestDids(t, ctx, k, subject)
+
+	require.True(t, HasPubKey(ctx, k, subject.Did, testPubKey(t, subject)))
+	require.False(t, HasPubKey(ctx, k, subject.Did, testPubKey(t, other)))
+	require.False(t, HasPubKey(ctx, k, other.Did, testPubKey(t, other)))
+
+	// the key set of a DID does not replace the key it was registered with
+	keySet := exported.NewMultisigKeySet(1, []string{other.VerifyKey})
+	require.Nil(t, k.SetKeySet(ctx, subject.Did, &keySet))
+	require.True(t, HasPubKey(ctx, k, subject.Did, testPubKey(t, subject)))
+	require.False(t, HasPubKey(ctx, k, subject.Did, keySet.PubKey()))
+}
@@ -30,6 +30,7 @@ const (
 	flagHDPath       = "hd-path"
 	flagGenerateOnly = "generate-only"
 	flagKeyAlgo      = "algo"
+	flagKeyType      = "key-type"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass      = "12345678"
@@ -53,9 +54,15 @@ func runGenerationOffline(cdc *codec.Codec) CommandDo {
 		var mnemonic string
 		var docCombine exported.IxoDid
 
-		algo := keys.SigningAlgo(viper.GetString(flagKeyAlgo))
-		if algo == keys.SigningAlgo("") {
+		// secp256k1 keys are the ones hardware wallets support
+		keyType := exported.KeyTypeOrDefault(viper.GetString(flagKeyType))
+		algo := keys.Ed25519
+		switch keyType {
+		case exported.KeyTypeEd25519:
+		case exported.KeyTypeSecp256k1:
 			algo = keys.Secp256k1
+		default:
+			return fmt.Errorf("unsupported key type %s", keyType)
 		}
 
 		//userEntropy, _ := flags.GetBool(flagUserEntropy)
@@ -77,7 +84,7 @@ func runGenerationOffline(cdc *codec.Codec) CommandDo {
 				return errors.New("aborted, not going to override this name")
 			}
 		}
-		didBuilder = didBuilder.WithName(name).Debug()
+		didBuilder = didBuilder.WithName(name).WithKeyType(keyType).Debug()
 		if useBIP44 {
 			docCombine = didBuilder.BuildDocBIP44(account, index, "")
 		} else {
@@ -86,7 +93,7 @@ func runGenerationOffline(cdc *codec.Codec) CommandDo {
 
 		mnemonic = didBuilder.GetMnemonicString()
 
-		docInfo, err := kb.CreateOffline(name, docCombine.FromPubKeyDx0(), keys.Ed25519)
+		docInfo, err := kb.CreateOffline(name, docCombine.FromPubKeyDx0(), algo)
 		if err != nil {
 			fmt.Println("failed to register key with name: ", name)
 		}
@@ -118,7 +125,7 @@ func runGenerationOffline(cdc *codec.Codec) CommandDo {
 		if !response {
 			return errors.New("aborted.")
 		}
-		msg := types.NewMsgAddDid(docCombine.Did, docCombine.GetPubKey())
+		msg := types.NewMsgAddDid(docCombine.Did, docCombine.GetPubKey(), docCombine.KeyType)
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(docCombine.Address())
 		preheat := aute2.NewDidTxBuild(cliCtx, msg, docCombine)
 
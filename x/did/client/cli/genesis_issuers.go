@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
+)
+
+const (
+	flagClientHome = "home-client"
+)
+
+func AddGenesisIssuerCmd(ctx *server.Context, cdc *codec.Codec,
+	defaultNodeHome, defaultClientHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-issuer [issuer-did] [cred-type][,[cred-type]]",
+		Short: "Add trusted credential issuer to genesis.json",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			issuerDid := exported.Did(args[0])
+			if len(issuerDid) == 0 {
+				return fmt.Errorf("issuer did cannot be empty")
+			}
+
+			credTypes, err := types.ParseCredTypes(args[1])
+			if err != nil {
+				return err
+			} else if len(credTypes) == 0 {
+				return fmt.Errorf("credential types cannot be empty")
+			}
+
+			issuer := types.NewTrustedIssuer(issuerDid, credTypes)
+			if err := issuer.Validate(); err != nil {
+				return err
+			}
+
+			// retrieve the app state
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			// add genesis issuer to the app state
+			var genesisState types.GenesisState
+
+			cdc.MustUnmarshalJSON(appState[types.ModuleName], &genesisState)
+
+			if genesisState.TrustedIssuers.Includes(issuer) {
+				return fmt.Errorf("cannot add issuer since it already exists")
+			}
+
+			genesisState.TrustedIssuers = append(genesisState.TrustedIssuers, issuer)
+
+			genesisStateBz := cdc.MustMarshalJSON(genesisState)
+			appState[types.ModuleName] = genesisStateBz
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			// export app state
+			genDoc.AppState = appStateJSON
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	return cmd
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
+)
+
+func GetCmdSetKeySet(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-key-set [did] [threshold] [pub-key][,[pub-key]] [signer-did-doc]",
+		Short: "Declare the k-of-n multisig key set that signs for a Did, or remove it with a threshold of 0",
+		Long: `Declare the k-of-n multisig key set that signs for a Did, or remove it with a threshold of 0.
+Once declared, txs of the Did must be signed by at least threshold of the keys and
+fees are paid from the account of the key set.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var threshold uint
+			if _, err := fmt.Sscan(args[1], &threshold); err != nil {
+				return err
+			}
+
+			var keySet *exported.MultisigKeySet
+			if threshold > 0 {
+				ks := exported.NewMultisigKeySet(threshold, strings.Split(args[2], ","))
+				if err := ks.Validate(); err != nil {
+					return err
+				}
+				keySet = &ks
+			}
+
+			sovrinDid, err := exported.UnmarshalDxpDid(args[3])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			msg := types.NewMsgSetKeySet(args[0], keySet)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdSignPartial(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-partial [sign-msg-json-file] [key-did-doc]",
+		Short: "Sign an unsigned tx offline with one key of a multisig key set",
+		Long: `Sign an unsigned tx offline with one key of a multisig key set and print the partial signature.
+The unsigned tx is the sign message printed with --generate-only, which must carry the
+account number and sequence of the account of the key set.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signMsg, err := readSignMsg(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			keyDid, err := exported.UnmarshalDxpDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(ante.SignMsgForSubSignature(keyDid, signMsg), "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdCombineSignatures(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "combine-signatures [sign-msg-json-file] [partial-signature-json-file]...",
+		Short: "Combine partial signatures of a multisig key set into a signed tx",
+		Long: `Combine the partial signatures made with sign-partial into a signed tx, which is
+printed so that it can be broadcast. The tx must have a single signer did.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signMsg, err := readSignMsg(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			subSignatures := make([]ante.IxoSubSignature, len(args)-1)
+			for i, file := range args[1:] {
+				bz, err := ioutil.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				if err := cdc.UnmarshalJSON(bz, &subSignatures[i]); err != nil {
+					return err
+				}
+			}
+
+			signature := ante.NewMultisigSignature(time.Now(), subSignatures)
+			tx := ante.NewIxoTx(signMsg.Msgs, signMsg.Fee, []ante.IxoSignature{signature}, signMsg.Memo)
+			if err := tx.ValidateBasic(); err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(tx, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func readSignMsg(cdc *codec.Codec, file string) (signMsg auth.StdSignMsg, err error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return signMsg, err
+	}
+	err = cdc.UnmarshalJSON(bz, &signMsg)
+	return signMsg, err
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
+)
+
+// GetCmdSubmitTrustedIssuerProposal implements a command handler for
+// submitting a trusted issuer proposal transaction.
+func GetCmdSubmitTrustedIssuerProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trusted-issuer [issuer-did] [cred-type][,[cred-type]] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to set the credential types an issuer is trusted for",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a trusted issuer proposal along with an initial deposit.
+Passing an empty credential type list ("") removes the issuer from the registry.
+
+Example:
+$ %s tx gov submit-proposal trusted-issuer did:dxp:VrsU9cUAcYgF7f397xtjsX ProofOfKYC "KYC provider" "Trust the KYC provider" 1000mdap --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			credTypes, err := types.ParseCredTypes(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			issuer := types.NewTrustedIssuer(args[0], credTypes)
+			content := types.NewTrustedIssuerProposal(args[2], args[3], issuer)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
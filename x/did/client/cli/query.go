@@ -3,9 +3,12 @@ package cli
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/tokenchain/dp-hub/client/utils"
 	"github.com/tokenchain/dp-hub/x/did/ante"
 	"github.com/tokenchain/dp-hub/x/did/exported"
@@ -67,12 +70,12 @@ func GetCmdDidDoc(cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdAllDids(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get-all-dids",
 		Short: "Query all DIDs",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute, keeper.QueryAllDids, "ALL")
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s%s", types.QuerierRoute, keeper.QueryAllDids, paginationPath(false))
 			if err != nil {
 				return err
 			}
@@ -89,15 +92,17 @@ func GetCmdAllDids(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	addPaginationFlags(cmd)
+	return cmd
 }
 
 func GetCmdAllDidDocs(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "get-all-did-docs",
 		Short: "Query all DID documents",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute, keeper.QueryAllDidDocs, "ALL")
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s%s", types.QuerierRoute, keeper.QueryAllDidDocs, paginationPath(false))
 			if err != nil {
 				return err
 			}
@@ -114,4 +119,179 @@ func GetCmdAllDidDocs(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+func GetCmdTrustedIssuers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-trusted-issuers",
+		Short: "Query trusted credential issuers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute, keeper.QueryIssuers)
+			if err != nil {
+				return err
+			}
+			var issuers types.TrustedIssuers
+			err = cdc.UnmarshalJSON(res, &issuers)
+			if err != nil {
+				return err
+			}
+			output, err := cdc.MarshalJSONIndent(issuers, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdDidByAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-did-by-address [address]",
+		Short: "Query the DID that controls an account address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute, keeper.QueryDidByAddress, args[0])
+			if err != nil {
+				return err
+			}
+			var did exported.Did
+			err = cdc.UnmarshalJSON(res, &did)
+			if err != nil {
+				return err
+			}
+			fmt.Println(did)
+			return nil
+		},
+	}
+}
+
+func GetCmdDidSequence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-did-sequence [did]",
+		Short: "Query the sequence that a DID signs its next tx with",
+		Long: `Query the sequence that a DID signs its next tx with. A DID without a stored sequence
+signs with the sequence of its account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute, keeper.QueryDidSequence, args[0])
+			if err != nil {
+				return err
+			}
+			var didSequence *exported.DidSequence
+			err = cdc.UnmarshalJSON(res, &didSequence)
+			if err != nil {
+				return err
+			}
+			if didSequence == nil {
+				return fmt.Errorf("no sequence stored for %s, it signs with the sequence of its account", args[0])
+			}
+			return cliCtx.PrintOutput(didSequence)
+		},
+	}
+}
+
+func GetCmdFeeAllowances(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-fee-allowances [granter-did]",
+		Short: "Query the fee allowances granted by a DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute, keeper.QueryFeeAllowances, args[0])
+			if err != nil {
+				return err
+			}
+			var allowances types.FeeAllowances
+			err = cdc.UnmarshalJSON(res, &allowances)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(allowances)
+		},
+	}
+}
+
+func GetCmdDidsByIssuer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-dids-by-issuer [issuer-did]",
+		Short: "Query DIDs holding a credential from an issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			if !exported.IsValidDid(args[0]) {
+				return errors.New("input is not a valid did")
+			}
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s%s", types.QuerierRoute, keeper.QueryDidsByIssuer, args[0], paginationPath(true))
+			if err != nil {
+				return err
+			}
+			return printDids(cdc, res)
+		},
+	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+func GetCmdDidsByCredType(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-dids-by-cred-type [cred-type]",
+		Short: "Query DIDs holding a credential of a type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s%s", types.QuerierRoute, keeper.QueryDidsByCredType, args[0], paginationPath(true))
+			if err != nil {
+				return err
+			}
+			return printDids(cdc, res)
+		},
+	}
+	addPaginationFlags(cmd)
+	return cmd
+}
+
+func printDids(cdc *codec.Codec, res []byte) error {
+	var dids []exported.Did
+	err := cdc.UnmarshalJSON(res, &dids)
+	if err != nil {
+		return err
+	}
+	output, err := cdc.MarshalJSONIndent(dids, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 0, "Query number of results returned per page (all results if not set)")
+}
+
+// paginationPath returns the page and limit query path segments set by the
+// pagination flags. If no limit was set, an empty path is returned so that
+// all results are queried, unless a default limit is required.
+func paginationPath(defaultLimit bool) string {
+	page := viper.GetInt(flags.FlagPage)
+	limit := viper.GetInt(flags.FlagLimit)
+	if limit <= 0 {
+		if !defaultLimit {
+			return ""
+		}
+		limit = keeper.DefaultQueryLimit
+	}
+	if page <= 0 {
+		page = 1
+	}
+	return fmt.Sprintf("/%d/%d", page, limit)
 }
@@ -1,15 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/tokenchain/dp-hub/x/did/ante"
 	"github.com/tokenchain/dp-hub/x/did/exported"
+	"io/ioutil"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tokenchain/dp-hub/x/did/internal/types"
 )
@@ -25,7 +30,7 @@ func GetCmdAddDidDoc(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			fmt.Println(sovrinDid)
-			msg := types.NewMsgAddDid(sovrinDid.Did, sovrinDid.GetPubKey())
+			msg := types.NewMsgAddDid(sovrinDid.Did, sovrinDid.GetPubKey(), sovrinDid.KeyType)
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
 			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
 		},
@@ -47,14 +52,194 @@ func GetCmdAddCredential(cdc *codec.Codec) *cobra.Command {
 			fmt.Println("Confirmed its a valid did document... ")
 			t := time.Now()
 			issued := t.Format(time.RFC3339)
-			credTypes := []string{"Credential", "ProofOfKYC"}
+			credTypes := []string{types.CredentialType, types.KYCCredentialType}
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
-			msg := types.NewMsgAddCredential(didAddr, credTypes, sovrinDid.Did, issued)
+			msg := types.NewMsgAddCredential(didAddr, credTypes, sovrinDid.Did, issued, types.KYCClaimSchema, types.KYCClaimBody)
 			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
 		},
 	}
 }
 
+func GetCmdAddGenericCredential(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-credential [did] [cred-type][,[cred-type]] [schema] [claim-json] [signer-did-doc]",
+		Short: "Add a new Credential with an arbitrary JSON claim for a Did by the signer",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			didAddr := args[0]
+			credTypes := strings.Split(args[1], ",")
+			schema := args[2]
+			claimBody := json.RawMessage(args[3])
+
+			sovrinDid, err := exported.UnmarshalDxpDid(args[4])
+			if err != nil {
+				return err
+			}
+
+			issued := time.Now().Format(time.RFC3339)
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			msg := types.NewMsgAddCredential(didAddr, credTypes, sovrinDid.Did, issued, schema, claimBody)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdAddController(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-controller [did] [controller-did] [signer-did-doc]",
+		Short: "Add a controller that may sign on behalf of a Did, signed by the Did or one of its controllers",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := exported.UnmarshalDxpDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			msg := types.NewMsgAddController(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdRemoveController(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-controller [did] [controller-did] [signer-did-doc]",
+		Short: "Remove a controller of a Did, signed by the Did or one of its controllers",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := exported.UnmarshalDxpDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			msg := types.NewMsgRemoveController(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+const (
+	FlagMsgTypes   = "msg-types"
+	FlagExpiration = "expiration"
+)
+
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-allowance [grantee-did] [spend-limit] [granter-did-doc]",
+		Short: "Let the granter Did pay the fees of txs signed by the grantee Did",
+		Long: `Let the granter Did pay the fees of txs signed by the grantee Did, up to the spend
+limit. An empty grantee ("") grants any Did, which then requires --msg-types. Txs use
+the allowance by naming the granter with --fee-granter.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := exported.UnmarshalDxpDid(args[2])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			var msgTypes []string
+			if s := viper.GetString(FlagMsgTypes); s != "" {
+				msgTypes = strings.Split(s, ",")
+			}
+
+			var expiration time.Time
+			if s := viper.GetString(FlagExpiration); s != "" {
+				expiration, err = time.Parse(time.RFC3339, s)
+				if err != nil {
+					return err
+				}
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			allowance := types.NewFeeAllowance(sovrinDid.Did, args[0], msgTypes, spendLimit, expiration)
+			msg := types.NewMsgGrantFeeAllowance(allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+	cmd.Flags().String(FlagMsgTypes, "", "Comma separated message types the allowance pays for, e.g. did/add-did")
+	cmd.Flags().String(FlagExpiration, "", "RFC3339 time the allowance expires at")
+	return cmd
+}
+
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-fee-allowance [grantee-did] [granter-did-doc]",
+		Short: "Revoke the fee allowance of the granter Did for the grantee Did",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := exported.UnmarshalDxpDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(sovrinDid.Address())
+			msg := types.NewMsgRevokeFeeAllowance(sovrinDid.Did, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return ante.NewDidTxBuild(cliCtx, msg, sovrinDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdMultiMsgTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "multi-msg [msgs-json-file] [signer-did-doc] [[signer-did-doc]...]",
+		Short: "Sign and broadcast several messages in one tx",
+		Long: `Sign and broadcast several messages in one tx, so that they all succeed or fail together.
+The file holds a JSON array of messages in the same format as the payload of a tx.
+One did doc is needed for every signer did, in the order the signers first appear
+in the messages. The first signer pays the fees.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			if err := cdc.UnmarshalJSON(bz, &msgs); err != nil {
+				return err
+			}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			signerDids := make([]exported.IxoDid, len(args)-1)
+			for i, arg := range args[1:] {
+				signerDids[i], err = exported.UnmarshalDxpDid(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(signerDids[0].Address())
+			return ante.NewDidMultiMsgTxBuild(cliCtx, msgs, signerDids).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
 /*
 func GetCmdDidGenerate(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -65,9 +250,11 @@ func GetCmdDidGenerate(cdc *codec.Codec) *cobra.Command {
 }
 */
 func GetCmdAccDidGenerate(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "generate-offline [name]",
 		Short: "Generate did document offline",
 		RunE:  runGenerationOffline(cdc),
 	}
+	cmd.Flags().String(flagKeyType, exported.KeyTypeEd25519, "Key type of the did, ed25519 or secp256k1")
+	return cmd
 }
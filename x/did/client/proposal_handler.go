@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tokenchain/dp-hub/x/did/client/cli"
+	"github.com/tokenchain/dp-hub/x/did/client/rest"
+)
+
+// ProposalHandler handles trusted issuer proposals
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitTrustedIssuerProposal, rest.ProposalRESTHandler)
@@ -23,6 +23,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/did", queryAllDidsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/allDidDocs", queryAllDidDocsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/checkName/{name}", queryCheckNameSystem(cliCtx)).Methods("GET")
+	r.HandleFunc("/trustedIssuers", queryTrustedIssuersRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didByAddress/{address}", queryDidByAddressRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didsByIssuer/{issuer}", queryDidsByIssuerRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didsByCredType/{credType}", queryDidsByCredTypeRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didSequence/{did}", queryDidSequenceRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/feeAllowances/{granterDid}", queryFeeAllowancesRequestHandler(cliCtx)).Methods("GET")
 }
 
 type (
@@ -72,7 +78,7 @@ func queryAddressFromDidRequestHandler(cliCtx context.CLIContext) http.HandlerFu
 		}
 		var didDoc types.BaseDidDoc
 		cliCtx.Codec.MustUnmarshalJSON(res, &didDoc)
-		address_dx0 := exported.VerifyKeyToAddrEd25519(didDoc.PubKey)
+		address_dx0 := didDoc.Address()
 		rest.PostProcessResponseBare(w, cliCtx, address_dx0)
 	}
 }
@@ -105,8 +111,12 @@ func queryDidDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDids)
+		pagination, ok := paginationPath(w, r, false)
+		if !ok {
+			return
+		}
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s%s", types.QuerierRoute,
+			keeper.QueryAllDids, pagination)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
@@ -128,8 +138,12 @@ func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDidDocs)
+		pagination, ok := paginationPath(w, r, false)
+		if !ok {
+			return
+		}
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s%s", types.QuerierRoute,
+			keeper.QueryAllDidDocs, pagination)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
@@ -148,3 +162,139 @@ func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		//rest.PostProcessResponse(w, cliCtx.Codec, didDocs, true)
 	}
 }
+
+func queryTrustedIssuersRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+			keeper.QueryIssuers)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query issuers. Error: %s", err.Error())))
+			return
+		}
+
+		var issuers types.TrustedIssuers
+		if err := cliCtx.Codec.UnmarshalJSON(res, &issuers); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshal data %s", err.Error())))
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, issuers)
+	}
+}
+
+func queryDidByAddressRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidByAddress, vars["address"])
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
+			return
+		}
+
+		var did exported.Did
+		cliCtx.Codec.MustUnmarshalJSON(res, &did)
+		rest.PostProcessResponseBare(w, cliCtx, did)
+	}
+}
+
+// queryDidSequenceRequestHandler responds with null for a DID without a stored
+// sequence, which signs with the sequence of its account
+func queryDidSequenceRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidSequence, vars["did"])
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did sequence. Error: %s", err.Error())))
+			return
+		}
+
+		var didSequence *exported.DidSequence
+		cliCtx.Codec.MustUnmarshalJSON(res, &didSequence)
+		rest.PostProcessResponseBare(w, cliCtx, didSequence)
+	}
+}
+
+func queryFeeAllowancesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryFeeAllowances, vars["granterDid"])
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query fee allowances. Error: %s", err.Error())))
+			return
+		}
+
+		var allowances types.FeeAllowances
+		cliCtx.Codec.MustUnmarshalJSON(res, &allowances)
+		rest.PostProcessResponseBare(w, cliCtx, allowances)
+	}
+}
+
+func queryDidsByIssuerRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		pagination, ok := paginationPath(w, r, true)
+		if !ok {
+			return
+		}
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s%s", types.QuerierRoute,
+			keeper.QueryDidsByIssuer, vars["issuer"], pagination)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query dids. Error: %s", err.Error())))
+			return
+		}
+
+		var dids []exported.Did
+		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+		rest.PostProcessResponseBare(w, cliCtx, dids)
+	}
+}
+
+func queryDidsByCredTypeRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		pagination, ok := paginationPath(w, r, true)
+		if !ok {
+			return
+		}
+		res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s%s", types.QuerierRoute,
+			keeper.QueryDidsByCredType, vars["credType"], pagination)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query dids. Error: %s", err.Error())))
+			return
+		}
+
+		var dids []exported.Did
+		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+		rest.PostProcessResponseBare(w, cliCtx, dids)
+	}
+}
+
+// paginationPath returns the query path segments for the page and limit
+// request parameters. Without a limit all results are queried, unless a
+// default limit is required.
+func paginationPath(w http.ResponseWriter, r *http.Request, defaultLimit bool) (string, bool) {
+	limitDefault := 0
+	if defaultLimit {
+		limitDefault = keeper.DefaultQueryLimit
+	}
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, limitDefault)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return "", false
+	}
+	if limit <= 0 {
+		return "", true
+	}
+	return fmt.Sprintf("/%d/%d", page, limit), true
+}
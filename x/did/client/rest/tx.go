@@ -1,11 +1,13 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/tokenchain/dp-hub/client/utils"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -19,6 +21,8 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/did", createDidRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/credential", addCredentialRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/controller", addControllerRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/controller", removeControllerRequestHandler(cliCtx)).Methods("DELETE")
 }
 
 func writeHeadf(w http.ResponseWriter, code int, format string, i ...interface{}) {
@@ -43,7 +47,7 @@ func createDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgAddDid(sovrinDid.Did, sovrinDid.GetPubKey())
+		msg := types.NewMsgAddDid(sovrinDid.Did, sovrinDid.GetPubKey(), sovrinDid.KeyType)
 
 		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, sovrinDid)
 		if err != nil {
@@ -60,6 +64,9 @@ func addCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		w.Header().Set("Content-Type", "application/json")
 		did := r.URL.Query().Get("did")
 		didDocParam := r.URL.Query().Get("signerDidDoc")
+		credTypesParam := r.URL.Query().Get("credTypes")
+		schema := r.URL.Query().Get("schema")
+		claimParam := r.URL.Query().Get("claim")
 		mode := r.URL.Query().Get("mode")
 		cliCtx = cliCtx.WithBroadcastMode(mode)
 
@@ -77,8 +84,24 @@ func addCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		t := time.Now()
 		issued := t.Format(time.RFC3339)
-		credTypes := []string{"Credential", "ProofOfKYC"}
-		msg := types.NewMsgAddCredential(did, credTypes, sovrinDid.Did, issued)
+		// Without an explicit claim the credential defaults to a KYC claim
+		credTypes := []string{types.CredentialType, types.KYCCredentialType}
+		claimBody := types.KYCClaimBody
+		if len(schema) == 0 {
+			schema = types.KYCClaimSchema
+		}
+		if len(credTypesParam) != 0 {
+			credTypes = strings.Split(credTypesParam, ",")
+		}
+		if len(claimParam) != 0 {
+			claimBody = json.RawMessage(claimParam)
+		}
+
+		msg := types.NewMsgAddCredential(did, credTypes, sovrinDid.Did, issued, schema, claimBody)
+		if err := msg.ValidateBasic(); err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
 		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, sovrinDid)
 		//output, err:= ante.NewDidTxBuild(cliCtx, msg, sovrinDid).
@@ -90,3 +113,65 @@ func addCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, output)
 	}
 }
+
+func addControllerRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		did := r.URL.Query().Get("did")
+		controllerDid := r.URL.Query().Get("controllerDid")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		sovrinDid, err := exported.UnmarshalDxpDid(didDocParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgAddController(did, controllerDid)
+		if err := msg.ValidateBasic(); err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, sovrinDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func removeControllerRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		did := r.URL.Query().Get("did")
+		controllerDid := r.URL.Query().Get("controllerDid")
+		didDocParam := r.URL.Query().Get("signerDidDoc")
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		sovrinDid, err := exported.UnmarshalDxpDid(didDocParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRemoveController(did, controllerDid)
+		if err := msg.ValidateBasic(); err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, sovrinDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
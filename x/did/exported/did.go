@@ -1,14 +1,18 @@
 package exported
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"strings"
 )
 
 type (
@@ -22,8 +26,9 @@ type (
 		AddressUnverified() sdk.AccAddress
 	}
 	Claim struct {
-		Id           Did  `json:"id" yaml:"id"`
-		KYCValidated bool `json:"KYCValidated" yaml:"KYCValidated"`
+		Id     Did             `json:"id" yaml:"id"`
+		Schema string          `json:"schema" yaml:"schema"`
+		Body   json.RawMessage `json:"body,omitempty" yaml:"body"`
 	}
 	DidCredential struct {
 		CredType []string `json:"type" yaml:"type"`
@@ -31,6 +36,14 @@ type (
 		Issued   string   `json:"issued" yaml:"issued"`
 		Claim    Claim    `json:"claim" yaml:"claim"`
 	}
+	// CredentialRequirement restricts a message type, given as
+	// "<route>/<type>", to signers holding a credential of CredType issued
+	// by one of Issuers (or by any trusted issuer if Issuers is empty).
+	CredentialRequirement struct {
+		MsgType  string `json:"msg_type" yaml:"msg_type"`
+		CredType string `json:"cred_type" yaml:"cred_type"`
+		Issuers  []Did  `json:"issuers" yaml:"issuers"`
+	}
 	Secret struct {
 		Seed                 string `json:"seed" yaml:"seed"`
 		SignKey              string `json:"signKey" yaml:"signKey"`
@@ -42,6 +55,7 @@ type (
 		EncryptionPublicKey string `json:"encryptionPublicKey" yaml:"encryptionPublicKey"`
 		Secret              Secret `json:"secret" yaml:"secret"`
 		Dpinfo              DpInfo `json:"dp" yaml:"dp"`
+		KeyType             string `json:"keyType,omitempty" yaml:"keyType"` // of the verify and sign keys, ed25519 if empty
 	}
 	DpInfo struct {
 		DpAddress string           `json:"address" yaml:"address"`
@@ -50,6 +64,7 @@ type (
 		Algo      keys.SigningAlgo `json:"algo" yaml:"algo"`
 	}
 	KeyGenerator struct {
+		keyType string
 		mem     string
 		pubkey  []byte
 		privkey []byte
@@ -59,6 +74,12 @@ type (
 	}
 )
 
+const (
+	MaxCredTypeLength    = 128
+	MaxClaimSchemaLength = 256
+	MaxClaimBodyLength   = 4096
+)
+
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*DidDoc)(nil), nil)
 	cdc.RegisterConcrete(&IxoDid{}, "darkpool/IxoDid", nil)
@@ -94,7 +115,15 @@ func (id IxoDid) FromPubKeyDx0() tmcrypto.PubKey {
 	return address
 }
 func (id IxoDid) Address() sdk.AccAddress {
-	return VerifyKeyToAddrEd25519(id.VerifyKey)
+	return VerifyKeyToAddress(id.VerifyKey, id.KeyType)
+}
+func (id IxoDid) GetKeyType() string {
+	return KeyTypeOrDefault(id.KeyType)
+}
+
+// VerifyPubKey returns the verify key of the did
+func (id IxoDid) VerifyPubKey() (tmcrypto.PubKey, error) {
+	return VerifyKeyToPubKey(id.VerifyKey, id.KeyType)
 }
 func (id IxoDid) AddressEd() sdk.AccAddress {
 	return UnverifiedToAddr(id.VerifyKey)
@@ -121,6 +150,12 @@ func (id IxoDid) GetPubKey() string {
 }
 
 func (id IxoDid) SignMessage(msg []byte) ([]byte, error) {
+	if id.GetKeyType() == KeyTypeSecp256k1 {
+		var privateKey secp256k1.PrivKeySecp256k1
+		copy(privateKey[:], base58.Decode(id.Secret.SignKey))
+		return privateKey.Sign(msg)
+	}
+
 	var privateKey ed25519tm.PrivKeyEd25519
 	copy(privateKey[:], base58.Decode(id.Secret.SignKey))
 	copy(privateKey[32:], base58.Decode(id.VerifyKey))
@@ -128,8 +163,10 @@ func (id IxoDid) SignMessage(msg []byte) ([]byte, error) {
 }
 
 func (id IxoDid) VerifySignedMessage(msg []byte, sig []byte) bool {
-	var publicKey ed25519tm.PubKeyEd25519
-	copy(publicKey[:], base58.Decode(id.VerifyKey))
+	publicKey, err := id.VerifyPubKey()
+	if err != nil {
+		return false
+	}
 	return publicKey.VerifyBytes(msg, sig)
 }
 
@@ -193,3 +230,93 @@ func (s Secret) String() string {
 	}
 	return fmt.Sprintf("%v", string(output))
 }
+
+func NewClaim(id Did, schema string, body json.RawMessage) Claim {
+	return Claim{
+		Id:     id,
+		Schema: schema,
+		Body:   body,
+	}
+}
+
+// Validate checks that the claim has a subject, a schema identifier and a
+// well-formed JSON body that does not exceed the size limits.
+func (c Claim) Validate() error {
+	if strings.TrimSpace(c.Id) == "" {
+		return errors.Wrap(ErrorInvalidDidE, "claim id should not be empty")
+	} else if !IsValidDid(c.Id) {
+		return errors.Wrap(ErrorInvalidDidE, "claim id is invalid")
+	}
+
+	if strings.TrimSpace(c.Schema) == "" {
+		return errors.Wrap(ErrorInvalidClaim, "claim schema should not be empty")
+	} else if len(c.Schema) > MaxClaimSchemaLength {
+		return errors.Wrapf(ErrorInvalidClaim, "claim schema exceeds %d characters", MaxClaimSchemaLength)
+	}
+
+	if len(c.Body) > MaxClaimBodyLength {
+		return errors.Wrapf(ErrorInvalidClaim, "claim body exceeds %d bytes", MaxClaimBodyLength)
+	} else if len(c.Body) > 0 && !json.Valid(c.Body) {
+		return errors.Wrap(ErrorInvalidClaim, "claim body is not valid json")
+	}
+
+	return nil
+}
+
+func (c Claim) Equals(other Claim) bool {
+	return c.Id == other.Id &&
+		c.Schema == other.Schema &&
+		bytes.Equal(c.Body, other.Body)
+}
+
+// HasType returns true if credType is one of the credential types.
+func (dc DidCredential) HasType(credType string) bool {
+	for _, t := range dc.CredType {
+		if t == credType {
+			return true
+		}
+	}
+	return false
+}
+
+// Duplicates returns true if both credentials were issued by the same issuer
+// with the same set of types and the same claim.
+func (dc DidCredential) Duplicates(other DidCredential) bool {
+	if dc.Issuer != other.Issuer || len(dc.CredType) != len(other.CredType) {
+		return false
+	}
+	for i := range dc.CredType {
+		if dc.CredType[i] != other.CredType[i] {
+			return false
+		}
+	}
+	return dc.Claim.Equals(other.Claim)
+}
+
+func NewCredentialRequirement(msgType string, credType string, issuers []Did) CredentialRequirement {
+	return CredentialRequirement{
+		MsgType:  msgType,
+		CredType: credType,
+		Issuers:  issuers,
+	}
+}
+
+// MsgTypeKey returns the "<route>/<type>" key used to match a message
+// against credential requirements.
+func MsgTypeKey(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+func (cr CredentialRequirement) Validate() error {
+	if len(strings.Split(cr.MsgType, "/")) != 2 {
+		return errors.Wrapf(ErrorInvalidCredentials, "message type %s must be of the form <route>/<type>", cr.MsgType)
+	} else if strings.TrimSpace(cr.CredType) == "" {
+		return errors.Wrap(ErrorInvalidCredentials, "credential type should not be empty")
+	}
+	for _, issuer := range cr.Issuers {
+		if !IsValidDid(issuer) {
+			return errors.Wrapf(ErrorInvalidIssuer, "issuer did %s is invalid", issuer)
+		}
+	}
+	return nil
+}
@@ -9,17 +9,23 @@ type CodeType = uint32
 type CodespaceType = string
 
 const (
+	moduleNameDid       = "did"
+	moduleNameBonddoc   = "bonddoc"
+	moduleNameIxo       = "dap"
+	moduleNamePayment   = "payments"
+	moduleNameProject   = "project"
+	moduleNameScheduler = "scheduler"
+	moduleNameTreasury  = "treasury"
+	moduleNameOracles   = "oracles"
 
-	moduleNameDid     = "did"
-	moduleNameBonddoc = "bonddoc"
-	moduleNameIxo     = "dap"
-	moduleNamePayment = "payments"
-	moduleNameProject = "project"
-
-	CodeInvalidDid         CodeType = 201
-	CodeInvalidPubKey      CodeType = 202
-	CodeInvalidIssuer      CodeType = 203
-	CodeInvalidCredentials CodeType = 204
+	CodeInvalidDid          CodeType = 201
+	CodeInvalidPubKey       CodeType = 202
+	CodeInvalidIssuer       CodeType = 203
+	CodeInvalidCredentials  CodeType = 204
+	CodeInvalidClaim        CodeType = 205
+	CodeUntrustedIssuer     CodeType = 206
+	CodeInvalidController   CodeType = 207
+	CodeInvalidFeeAllowance CodeType = 208
 
 	CodeNameDoesNotExist       CodeType = 325
 	CodeInternalBondDic        CodeType = 326
@@ -47,6 +53,29 @@ const (
 	CodeInvalidArgument              CodeType = 110
 	CodeAlreadyExists                CodeType = 111
 	CodeInvalidCoin                  CodeType = 112
+
+	//scheduler
+	CodeInvalidScheduledTx  CodeType = 401
+	CodeScheduledTxNotFound CodeType = 402
+
+	//treasury
+	CodeOperationDisabled   CodeType = 501
+	CodeAddressBlacklisted  CodeType = 502
+	CodeInvalidOracleProof  CodeType = 503
+	CodeOracleProofSpent    CodeType = 504
+	CodeOracleLimitExceeded CodeType = 505
+	CodeTooManyOutputs      CodeType = 506
+	CodeInvalidEscrow       CodeType = 507
+	CodeEscrowNotFound      CodeType = 508
+
+	//oracles
+	CodeInvalidOracle        CodeType = 601
+	CodeOracleNotFound       CodeType = 602
+	CodeOracleAlreadyExists  CodeType = 603
+	CodeInvalidPriceVote     CodeType = 604
+	CodeExchangeRateNotFound CodeType = 605
+	CodeInvalidBond          CodeType = 606
+	CodeInsufficientBond     CodeType = 607
 )
 
 var (
@@ -56,6 +85,10 @@ var (
 	ErrorInvalidPubKey        = errors.Register(moduleNameDid, CodeInvalidPubKey, "invalid pubkey")
 	ErrorInvalidIssuer        = errors.Register(moduleNameDid, CodeInvalidIssuer, "invalid issuer")
 	ErrorInvalidCredentials   = errors.Register(moduleNameDid, CodeInvalidCredentials, "Data already exist")
+	ErrorInvalidClaim         = errors.Register(moduleNameDid, CodeInvalidClaim, "invalid claim")
+	ErrorUntrustedIssuer      = errors.Register(moduleNameDid, CodeUntrustedIssuer, "issuer not trusted for credential type")
+	ErrorInvalidController    = errors.Register(moduleNameDid, CodeInvalidController, "invalid controller")
+	ErrorInvalidFeeAllowance  = errors.Register(moduleNameDid, CodeInvalidFeeAllowance, "invalid fee allowance")
 	ErrNameDoesNotExist       = errors.Register(moduleNameBonddoc, CodeNameDoesNotExist, "name does not exist")
 	ErrInternalE              = errors.Register(moduleNameBonddoc, CodeInternalBondDic, "bond did not found")
 	ErrGasOverflow            = errors.Register(moduleNameBonddoc, CodeInvalidDid, "Gas invalid supply")
@@ -81,6 +114,23 @@ var (
 	EInvalidArgs              = errors.Register(moduleNamePayment, CodeInvalidArgument, "payment invalid")
 	EAlreadyExists            = errors.Register(moduleNamePayment, CodeAlreadyExists, "payment invalid")
 	EInvalidCoin              = errors.Register(moduleNameProject, CodeInvalidCoin, "coin is invalid")
+	EInvalidScheduledTx       = errors.Register(moduleNameScheduler, CodeInvalidScheduledTx, "invalid scheduled tx")
+	EScheduledTxNotFound      = errors.Register(moduleNameScheduler, CodeScheduledTxNotFound, "scheduled tx not found")
+	EOperationDisabled        = errors.Register(moduleNameTreasury, CodeOperationDisabled, "treasury operation disabled")
+	EAddressBlacklisted       = errors.Register(moduleNameTreasury, CodeAddressBlacklisted, "address is blacklisted")
+	EInvalidOracleProof       = errors.Register(moduleNameTreasury, CodeInvalidOracleProof, "invalid oracle proof")
+	EOracleProofSpent         = errors.Register(moduleNameTreasury, CodeOracleProofSpent, "oracle proof already spent")
+	EOracleLimitExceeded      = errors.Register(moduleNameTreasury, CodeOracleLimitExceeded, "oracle limit exceeded")
+	ETooManyOutputs           = errors.Register(moduleNameTreasury, CodeTooManyOutputs, "too many multi-send outputs")
+	EInvalidEscrow            = errors.Register(moduleNameTreasury, CodeInvalidEscrow, "invalid escrow")
+	EEscrowNotFound           = errors.Register(moduleNameTreasury, CodeEscrowNotFound, "escrow not found")
+	EInvalidOracle            = errors.Register(moduleNameOracles, CodeInvalidOracle, "invalid oracle")
+	EOracleNotFound           = errors.Register(moduleNameOracles, CodeOracleNotFound, "oracle not found")
+	EOracleAlreadyExists      = errors.Register(moduleNameOracles, CodeOracleAlreadyExists, "oracle already exists")
+	EInvalidPriceVote         = errors.Register(moduleNameOracles, CodeInvalidPriceVote, "invalid price vote")
+	EExchangeRateNotFound     = errors.Register(moduleNameOracles, CodeExchangeRateNotFound, "exchange rate not found")
+	EInvalidBond              = errors.Register(moduleNameOracles, CodeInvalidBond, "invalid oracle bond")
+	EInsufficientBond         = errors.Register(moduleNameOracles, CodeInsufficientBond, "insufficient oracle bond")
 )
 
 func ErrInvalidDid(args string) error {
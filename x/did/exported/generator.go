@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/go-bip39"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	edgen "github.com/tokenchain/dp-hub/x/did/ed25519"
 	naclBox "golang.org/x/crypto/nacl/box"
 )
@@ -51,6 +52,12 @@ func (s KeyGenerator) WithPrivKey(n []byte) KeyGenerator {
 	return s
 }
 
+// WithKeyType sets the type of the keys to generate, ed25519 by default
+func (s KeyGenerator) WithKeyType(keyType string) KeyGenerator {
+	s.keyType = keyType
+	return s
+}
+
 func (s KeyGenerator) WithMem(n string) KeyGenerator {
 	s.mem = n
 	return s
@@ -94,6 +101,10 @@ func (s KeyGenerator) makePubKey(bt *[32]byte) (pubKey tmcrypto.PubKey) {
 	return pubKeyRaw
 }
 func (s KeyGenerator) generateFinal() IxoDid {
+	if s.keyType == KeyTypeSecp256k1 {
+		return s.generateFinalSecp256k1()
+	}
+
 	publicKeyBytes, privateKeyBytes, err := edgen.GenerateKey(bytes.NewReader(s.seed[:32]))
 	if err != nil {
 		panic(err)
@@ -135,6 +146,39 @@ func (s KeyGenerator) generateFinal() IxoDid {
 	return sovDid
 }
 
+// generateFinalSecp256k1 uses the seed as the secp256k1 sign key. For a BIP44
+// seed this is the key that a hardware wallet derives for the same path.
+func (s KeyGenerator) generateFinalSecp256k1() IxoDid {
+	var privateKey secp256k1.PrivKeySecp256k1
+	copy(privateKey[:], s.seed[:32])
+	publicKey := privateKey.PubKey().(secp256k1.PubKeySecp256k1)
+
+	keyPairPublicKey, keyPairPrivateKey, err := naclBox.GenerateKey(bytes.NewReader(privateKey[:]))
+	if err != nil {
+		panic(err)
+	}
+	// the first byte of a compressed key only tells the parity of y
+	return IxoDid{
+		Did:                 dxpDidAddress(base58.Encode(publicKey[1:17])),
+		VerifyKey:           base58.Encode(publicKey[:]),
+		EncryptionPublicKey: base58.Encode(keyPairPublicKey[:]),
+
+		Secret: Secret{
+			Seed:                 hex.EncodeToString(s.seed[:32]),
+			SignKey:              base58.Encode(privateKey[:]),
+			EncryptionPrivateKey: base58.Encode(keyPairPrivateKey[:]),
+		},
+
+		Dpinfo: DpInfo{
+			DpAddress: sdk.AccAddress(publicKey.Address()).String(),
+			PubKey:    sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, publicKey),
+			Name:      s.name,
+			Algo:      keys.Secp256k1,
+		},
+		KeyType: KeyTypeSecp256k1,
+	}
+}
+
 //to get some parameters from the builder
 func (s KeyGenerator) Pre() KeyGenerator {
 	return s.generateMnemonic().generateSeed()
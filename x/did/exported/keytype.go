@@ -0,0 +1,78 @@
+package exported
+
+import (
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	ed25519tm "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// Key types of the verify key of a DID. DID documents from before key types
+// were declared have no key type and hold ed25519 keys.
+const (
+	KeyTypeEd25519   = "ed25519"
+	KeyTypeSecp256k1 = "secp256k1"
+)
+
+// KeyTypeOrDefault returns the key type, or ed25519 if none is declared
+func KeyTypeOrDefault(keyType string) string {
+	if keyType == "" {
+		return KeyTypeEd25519
+	}
+	return keyType
+}
+
+// ValidateVerifyKey checks that the base58 verify key is a key of the key type
+func ValidateVerifyKey(verifyKey, keyType string) error {
+	_, err := VerifyKeyToPubKey(verifyKey, keyType)
+	return err
+}
+
+// VerifyKeyToPubKey decodes a base58 verify key of the key type. A secp256k1
+// key is expected in its 33 byte compressed form.
+func VerifyKeyToPubKey(verifyKey, keyType string) (tmcrypto.PubKey, error) {
+	bz := base58.Decode(verifyKey)
+	switch KeyTypeOrDefault(keyType) {
+	case KeyTypeEd25519:
+		if len(bz) != ed25519tm.PubKeyEd25519Size {
+			return nil, errors.Wrapf(ErrorInvalidPubKey, "invalid ed25519 key %s", verifyKey)
+		}
+		var pubKey ed25519tm.PubKeyEd25519
+		copy(pubKey[:], bz)
+		return pubKey, nil
+	case KeyTypeSecp256k1:
+		if len(bz) != secp256k1.PubKeySecp256k1Size || (bz[0] != 0x02 && bz[0] != 0x03) {
+			return nil, errors.Wrapf(ErrorInvalidPubKey, "invalid compressed secp256k1 key %s", verifyKey)
+		}
+		var pubKey secp256k1.PubKeySecp256k1
+		copy(pubKey[:], bz)
+		return pubKey, nil
+	default:
+		return nil, errors.Wrapf(ErrorInvalidPubKey, "unsupported key type %s", keyType)
+	}
+}
+
+// ParseVerifyKey decodes a base58 verify key whose key type is not declared,
+// such as a key of a multisig key set. The key types are told apart by the
+// length of their keys, 32 bytes for ed25519 and 33 for compressed secp256k1.
+func ParseVerifyKey(verifyKey string) (tmcrypto.PubKey, error) {
+	if len(base58.Decode(verifyKey)) == secp256k1.PubKeySecp256k1Size {
+		return VerifyKeyToPubKey(verifyKey, KeyTypeSecp256k1)
+	}
+	return VerifyKeyToPubKey(verifyKey, KeyTypeEd25519)
+}
+
+// VerifyKeyToAddress returns the address of the account of a verify key. A
+// secp256k1 key that does not decode has no account and an empty address.
+func VerifyKeyToAddress(verifyKey, keyType string) sdk.AccAddress {
+	if KeyTypeOrDefault(keyType) == KeyTypeEd25519 {
+		return VerifyKeyToAddrEd25519(verifyKey)
+	}
+	pubKey, err := VerifyKeyToPubKey(verifyKey, keyType)
+	if err != nil {
+		return sdk.AccAddress{}
+	}
+	return sdk.AccAddress(pubKey.Address())
+}
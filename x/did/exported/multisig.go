@@ -0,0 +1,65 @@
+package exported
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+// MaxMultisigKeys is the maximum number of keys in a multisig key set
+const MaxMultisigKeys = 16
+
+// MultisigKeySet is a k-of-n set of ed25519 or secp256k1 verify keys, which
+// may be mixed. A DID document that declares one is signed for by at least
+// Threshold of the keys.
+type MultisigKeySet struct {
+	Threshold uint     `json:"threshold" yaml:"threshold"`
+	PubKeys   []string `json:"pubKeys" yaml:"pubKeys"` // base58 verify keys, secp256k1 ones compressed
+}
+
+func NewMultisigKeySet(threshold uint, pubKeys []string) MultisigKeySet {
+	return MultisigKeySet{
+		Threshold: threshold,
+		PubKeys:   pubKeys,
+	}
+}
+
+func (ks MultisigKeySet) Validate() error {
+	if len(ks.PubKeys) == 0 {
+		return errors.Wrap(ErrorInvalidPubKey, "multisig key set has no keys")
+	} else if len(ks.PubKeys) > MaxMultisigKeys {
+		return errors.Wrapf(ErrorInvalidPubKey, "multisig key set has more than %d keys", MaxMultisigKeys)
+	} else if ks.Threshold == 0 || ks.Threshold > uint(len(ks.PubKeys)) {
+		return errors.Wrapf(ErrorInvalidPubKey, "threshold must be between 1 and %d", len(ks.PubKeys))
+	}
+
+	for i, pubKey := range ks.PubKeys {
+		if _, err := ParseVerifyKey(pubKey); err != nil {
+			return errors.Wrapf(ErrorInvalidPubKey, "invalid multisig key %s", pubKey)
+		}
+		for _, other := range ks.PubKeys[:i] {
+			if bytes.Equal(base58.Decode(pubKey), base58.Decode(other)) {
+				return errors.Wrapf(ErrorInvalidPubKey, "duplicate multisig key %s", pubKey)
+			}
+		}
+	}
+	return nil
+}
+
+// PubKey returns the threshold public key of the key set. The key set must be valid.
+func (ks MultisigKeySet) PubKey() tmcrypto.PubKey {
+	pubKeys := make([]tmcrypto.PubKey, len(ks.PubKeys))
+	for i, verifyKey := range ks.PubKeys {
+		pubKeys[i], _ = ParseVerifyKey(verifyKey)
+	}
+	return multisig.NewPubKeyMultisigThreshold(int(ks.Threshold), pubKeys)
+}
+
+// Address returns the address of the account that belongs to the key set
+func (ks MultisigKeySet) Address() sdk.AccAddress {
+	return sdk.AccAddress(ks.PubKey().Address())
+}
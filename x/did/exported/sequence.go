@@ -0,0 +1,15 @@
+package exported
+
+// DidSequence is the number of txs signed for a DID. It is the nonce in the
+// sign bytes of the next tx of the DID, so that a signed tx is accepted once.
+type DidSequence struct {
+	Did      Did    `json:"did" yaml:"did"`
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+}
+
+func NewDidSequence(did Did, sequence uint64) DidSequence {
+	return DidSequence{
+		Did:      did,
+		Sequence: sequence,
+	}
+}
@@ -12,11 +12,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 			keeper.AddDidDoc(ctx, d)
 		}
 	}
+	// Initialise params
+	keeper.SetParams(ctx, data.Params)
+
+	// Initialise trusted issuers
+	for _, ti := range data.TrustedIssuers {
+		keeper.SetTrustedIssuer(ctx, ti)
+	}
+
+	// Initialise did sequences
+	for _, ds := range data.Sequences {
+		keeper.SetDidSequence(ctx, ds.Did, ds.Sequence)
+	}
+
+	// Initialise fee allowances
+	for _, fa := range data.FeeAllowances {
+		keeper.SetFeeAllowance(ctx, fa)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 	return GenesisState{
-		DidDocs: keeper.GetAllDidDocs(ctx),
+		DidDocs:        keeper.GetAllDidDocs(ctx),
+		TrustedIssuers: keeper.GetTrustedIssuers(ctx),
+		Params:         keeper.GetParams(ctx),
+		Sequences:      keeper.GetDidSequences(ctx),
+		FeeAllowances:  keeper.GetFeeAllowances(ctx, ""),
 	}
 }
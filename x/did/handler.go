@@ -2,7 +2,11 @@ package did
 
 import (
 	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	er "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 
 	"github.com/tokenchain/dp-hub/x/did/internal/keeper"
@@ -16,6 +20,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgAddDidDoc(ctx, k, msg)
 		case types.MsgAddCredential:
 			return handleMsgAddCredential(ctx, k, msg)
+		case types.MsgAddController:
+			return handleMsgAddController(ctx, k, msg)
+		case types.MsgRemoveController:
+			return handleMsgRemoveController(ctx, k, msg)
+		case types.MsgSetKeySet:
+			return handleMsgSetKeySet(ctx, k, msg)
+		case types.MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, k, msg)
+		case types.MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, k, msg)
 		default:
 			return nil, exported.UnknownRequest("No match for message type.")
 		}
@@ -38,6 +52,11 @@ func handleMsgAddDidDoc(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddDid) (
 }
 
 func handleMsgAddCredential(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddCredential) (*sdk.Result, error) {
+	credential := msg.DidCredential
+	if !k.IsTrustedIssuerFor(ctx, credential.Issuer, credential.CredType) {
+		return nil, er.Wrapf(exported.ErrorUntrustedIssuer, "%s cannot issue %v", credential.Issuer, credential.CredType)
+	}
+
 	err := k.AddCredentials(ctx, msg.DidCredential.Claim.Id, msg.DidCredential)
 	if err != nil {
 		return nil, err
@@ -45,3 +64,119 @@ func handleMsgAddCredential(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddCr
 	fmt.Println("handleMsgAddCredential complete")
 	return &sdk.Result{}, nil
 }
+
+func handleMsgAddController(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddController) (*sdk.Result, error) {
+	if err := k.AddController(ctx, msg.Did, msg.ControllerDid); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddController,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeyControllerDid, msg.ControllerDid),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveController(ctx sdk.Context, k keeper.Keeper, msg types.MsgRemoveController) (*sdk.Result, error) {
+	if err := k.RemoveController(ctx, msg.Did, msg.ControllerDid); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveController,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeyControllerDid, msg.ControllerDid),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetKeySet(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetKeySet) (*sdk.Result, error) {
+	if err := k.SetKeySet(ctx, msg.Did, msg.KeySet); err != nil {
+		return nil, err
+	}
+
+	var threshold uint
+	if msg.KeySet != nil {
+		threshold = msg.KeySet.Threshold
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetKeySet,
+			sdk.NewAttribute(types.AttributeKeyDid, msg.Did),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprint(threshold)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func NewTrustedIssuerProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.TrustedIssuerProposal:
+			return handleTrustedIssuerProposal(ctx, k, c)
+		default:
+			return exported.UnknownRequest(fmt.Sprintf("unrecognized did proposal content type: %T", c))
+		}
+	}
+}
+
+func handleTrustedIssuerProposal(ctx sdk.Context, k keeper.Keeper, p types.TrustedIssuerProposal) error {
+	if len(p.Issuer.CredTypes) == 0 {
+		k.RemoveTrustedIssuer(ctx, p.Issuer.IssuerDid)
+	} else {
+		k.SetTrustedIssuer(ctx, p.Issuer)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTrustedIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuerDid, p.Issuer.IssuerDid),
+			sdk.NewAttribute(types.AttributeKeyCredTypes, strings.Join(p.Issuer.CredTypes, ",")),
+		),
+	)
+	return nil
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrantFeeAllowance) (*sdk.Result, error) {
+	allowance := msg.Allowance
+	if _, err := k.GetDidDoc(ctx, allowance.GranterDid); err != nil {
+		return nil, err
+	}
+	k.SetFeeAllowance(ctx, allowance)
+
+	var expiration string
+	if !allowance.Expiration.IsZero() {
+		expiration = allowance.Expiration.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranterDid, allowance.GranterDid),
+			sdk.NewAttribute(types.AttributeKeyGranteeDid, allowance.GranteeDid),
+			sdk.NewAttribute(types.AttributeKeyMsgTypes, strings.Join(allowance.MsgTypes, ",")),
+			sdk.NewAttribute(types.AttributeKeySpendLimit, allowance.SpendLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, expiration),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeFeeAllowance) (*sdk.Result, error) {
+	if err := k.RevokeFeeAllowance(ctx, msg.GranterDid, msg.GranteeDid); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranterDid, msg.GranterDid),
+			sdk.NewAttribute(types.AttributeKeyGranteeDid, msg.GranteeDid),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
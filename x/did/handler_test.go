@@ -0,0 +1,39 @@
+package did
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
+)
+
+func TestHandleMsgAddCredential(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewHandler(k)
+	subject := exported.NewDidGeneratorBuilder().Build()
+	issuer := exported.NewDidGeneratorBuilder().Build()
+	addTestDids(t, ctx, k, subject, issuer)
+
+	credTypes := []string{types.CredentialType, types.KYCCredentialType}
+	msg := types.NewMsgAddCredential(subject.Did, credTypes, issuer.Did, "2020-01-01T00:00:00Z",
+		types.KYCClaimSchema, types.KYCClaimBody)
+
+	// an issuer that is not trusted for the credential types cannot add it
+	_, err := handler(ctx, msg)
+	require.NotNil(t, err)
+	require.False(t, k.HasValidCredential(ctx, subject.Did, types.KYCCredentialType, []exported.Did{issuer.Did}))
+
+	// nor can an issuer trusted for other types only
+	k.SetTrustedIssuer(ctx, types.NewTrustedIssuer(issuer.Did, []string{"ProofOfAge"}))
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	// a registered issuer can
+	k.SetTrustedIssuer(ctx, types.NewTrustedIssuer(issuer.Did, credTypes))
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, k.HasValidCredential(ctx, subject.Did, types.KYCCredentialType, nil))
+}
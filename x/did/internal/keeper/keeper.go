@@ -5,23 +5,50 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	er "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 
 	"github.com/tokenchain/dp-hub/x/did/internal/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      *codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace) Keeper {
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 }
 
+// GetParams returns the total set of did parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of did parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetCredentialRequirements returns the credential requirements that apply to
+// a message type. No requirements apply if the parameter was never set.
+func (k Keeper) GetCredentialRequirements(ctx sdk.Context, msgType string) (requirements []exported.CredentialRequirement) {
+	var all []exported.CredentialRequirement
+	k.paramSpace.GetIfExists(ctx, types.KeyCredentialRequirements, &all)
+	for _, cr := range all {
+		if cr.MsgType == msgType {
+			requirements = append(requirements, cr)
+		}
+	}
+	return requirements
+}
+
 func (k Keeper) GetDidDoc(ctx sdk.Context, did exported.Did) (exported.DidDoc, error) {
 	store := ctx.KVStore(k.storeKey)
 	//fmt.Println("KVStore occurred: ", store)
@@ -67,6 +94,7 @@ func (k Keeper) AddDidDoc(ctx sdk.Context, did exported.DidDoc) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetDidPrefixKey(did.GetDid())
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(did))
+	k.setDidIndexes(ctx, did)
 }
 
 func (k Keeper) AddDidDocDebug(ctx sdk.Context, did exported.DidDoc) error {
@@ -77,9 +105,41 @@ func (k Keeper) AddDidDocDebug(ctx sdk.Context, did exported.DidDoc) error {
 		return err
 	}
 	store.Set(key, data)
+	k.setDidIndexes(ctx, did)
 	return nil
 }
 
+// setDidIndexes records the address of a DID document and the issuers and
+// types of its credentials so that the DID can be looked up by them
+func (k Keeper) setDidIndexes(ctx sdk.Context, did exported.DidDoc) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAddressPrefixKey(did.Address()), []byte(did.GetDid()))
+
+	baseDidDoc, ok := did.(types.BaseDidDoc)
+	if !ok {
+		if ptr, isPtr := did.(*types.BaseDidDoc); isPtr {
+			baseDidDoc, ok = *ptr, true
+		}
+	}
+	if !ok {
+		return
+	}
+	if baseDidDoc.KeySet != nil {
+		store.Set(types.GetAddressPrefixKey(baseDidDoc.KeySet.Address()), []byte(did.GetDid()))
+	}
+	for _, cred := range baseDidDoc.GetCredentials() {
+		k.setCredentialIndexes(ctx, baseDidDoc.GetDid(), cred)
+	}
+}
+
+func (k Keeper) setCredentialIndexes(ctx sdk.Context, did exported.Did, cred exported.DidCredential) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetIssuerIndexKey(cred.Issuer, did), []byte(did))
+	for _, credType := range cred.CredType {
+		store.Set(types.GetCredTypeIndexKey(credType, did), []byte(did))
+	}
+}
+
 func (k Keeper) AddCredentials(ctx sdk.Context, did exported.Did, credential exported.DidCredential) (err error) {
 	existedDid, err := k.GetDidDoc(ctx, did)
 	if err != nil {
@@ -90,7 +150,7 @@ func (k Keeper) AddCredentials(ctx sdk.Context, did exported.Did, credential exp
 	credentials := baseDidDoc.GetCredentials()
 
 	for _, data := range credentials {
-		if data.Issuer == credential.Issuer && data.CredType[0] == credential.CredType[0] && data.CredType[1] == credential.CredType[1] && data.Claim.KYCValidated == credential.Claim.KYCValidated {
+		if data.Duplicates(credential) {
 			return er.Wrap(exported.ErrorInvalidCredentials, "credentials already exist")
 		}
 	}
@@ -101,6 +161,73 @@ func (k Keeper) AddCredentials(ctx sdk.Context, did exported.Did, credential exp
 	return nil
 }
 
+// AddController lists a controller DID that may sign on behalf of a DID. The
+// controller must itself have a DID document.
+func (k Keeper) AddController(ctx sdk.Context, did exported.Did, controllerDid exported.Did) error {
+	existedDid, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return err
+	}
+	if _, err := k.GetDidDoc(ctx, controllerDid); err != nil {
+		return er.Wrapf(exported.ErrorInvalidController, "controller %s does not exist", controllerDid)
+	}
+
+	baseDidDoc := existedDid.(types.BaseDidDoc)
+	if baseDidDoc.HasController(controllerDid) {
+		return er.Wrapf(exported.ErrorInvalidController, "%s is already a controller", controllerDid)
+	} else if len(baseDidDoc.GetControllers()) >= types.MaxControllers {
+		return er.Wrapf(exported.ErrorInvalidController, "a did cannot have more than %d controllers", types.MaxControllers)
+	}
+
+	baseDidDoc.AddController(controllerDid)
+	k.AddDidDoc(ctx, baseDidDoc)
+	return nil
+}
+
+// RemoveController removes a controller DID from a DID document
+func (k Keeper) RemoveController(ctx sdk.Context, did exported.Did, controllerDid exported.Did) error {
+	existedDid, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return err
+	}
+
+	baseDidDoc := existedDid.(types.BaseDidDoc)
+	if !baseDidDoc.HasController(controllerDid) {
+		return er.Wrapf(exported.ErrorInvalidController, "%s is not a controller", controllerDid)
+	}
+
+	baseDidDoc.RemoveController(controllerDid)
+	k.AddDidDoc(ctx, baseDidDoc)
+	return nil
+}
+
+// SetKeySet declares the multisig key set of a DID, or removes it if nil
+func (k Keeper) SetKeySet(ctx sdk.Context, did exported.Did, keySet *exported.MultisigKeySet) error {
+	existedDid, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return err
+	}
+
+	// the account of the replaced key set no longer resolves to the DID
+	baseDidDoc := existedDid.(types.BaseDidDoc)
+	if baseDidDoc.KeySet != nil {
+		ctx.KVStore(k.storeKey).Delete(types.GetAddressPrefixKey(baseDidDoc.KeySet.Address()))
+	}
+
+	baseDidDoc.KeySet = keySet
+	k.AddDidDoc(ctx, baseDidDoc)
+	return nil
+}
+
+// IsController checks if a DID document lists the controller DID
+func (k Keeper) IsController(ctx sdk.Context, did exported.Did, controllerDid exported.Did) bool {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return false
+	}
+	return didDoc.(types.BaseDidDoc).HasController(controllerDid)
+}
+
 func (k Keeper) GetAllDidDocs(ctx sdk.Context) (didDocs []exported.DidDoc) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.DidKey)
@@ -122,3 +249,294 @@ func (k Keeper) GetAddDids(ctx sdk.Context) (dids []exported.Did) {
 
 	return dids
 }
+
+// GetDidDocsPaginated returns a single page of DID documents. Pages are
+// numbered from 1.
+func (k Keeper) GetDidDocsPaginated(ctx sdk.Context, page, limit int) (didDocs []exported.DidDoc) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DidKey)
+	defer iterator.Close()
+	iteratePage(iterator, page, limit, func(value []byte) {
+		var didDoc types.BaseDidDoc
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &didDoc)
+		didDocs = append(didDocs, &didDoc)
+	})
+
+	return didDocs
+}
+
+// GetDidsPaginated returns a single page of DIDs. Pages are numbered from 1.
+func (k Keeper) GetDidsPaginated(ctx sdk.Context, page, limit int) (dids []exported.Did) {
+	for _, didDoc := range k.GetDidDocsPaginated(ctx, page, limit) {
+		dids = append(dids, didDoc.GetDid())
+	}
+
+	return dids
+}
+
+// GetDidByAddress returns the DID whose public key derives the address
+func (k Keeper) GetDidByAddress(ctx sdk.Context, address sdk.AccAddress) (exported.Did, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAddressPrefixKey(address))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetDidsByIssuer returns a single page of DIDs holding a credential from the issuer
+func (k Keeper) GetDidsByIssuer(ctx sdk.Context, issuerDid exported.Did, page, limit int) []exported.Did {
+	return k.getIndexedDids(ctx, types.GetIssuerIndexPrefixKey(issuerDid), page, limit)
+}
+
+// GetDidsByCredType returns a single page of DIDs holding a credential of the type
+func (k Keeper) GetDidsByCredType(ctx sdk.Context, credType string, page, limit int) []exported.Did {
+	return k.getIndexedDids(ctx, types.GetCredTypeIndexPrefixKey(credType), page, limit)
+}
+
+func (k Keeper) getIndexedDids(ctx sdk.Context, prefix []byte, page, limit int) (dids []exported.Did) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	iteratePage(iterator, page, limit, func(value []byte) {
+		dids = append(dids, string(value))
+	})
+
+	return dids
+}
+
+// iteratePage calls fn with the values on the requested page, skipping the
+// entries of all previous pages
+func iteratePage(iterator sdk.Iterator, page, limit int, fn func(value []byte)) {
+	if page < 1 || limit < 1 {
+		return
+	}
+	skip := (page - 1) * limit
+	for ; iterator.Valid() && limit > 0; iterator.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		fn(iterator.Value())
+		limit--
+	}
+}
+
+// GetDidSequence returns the sequence of a DID if it has signed a tx since
+// sequences are stored per DID
+func (k Keeper) GetDidSequence(ctx sdk.Context, did exported.Did) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSequencePrefixKey(did))
+	if bz == nil {
+		return 0, false
+	}
+
+	var sequence uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sequence)
+	return sequence, true
+}
+
+func (k Keeper) SetDidSequence(ctx sdk.Context, did exported.Did, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSequencePrefixKey(did), k.cdc.MustMarshalBinaryLengthPrefixed(sequence))
+}
+
+// GetDidSequences returns the stored sequences of all DIDs
+func (k Keeper) GetDidSequences(ctx sdk.Context) (sequences []exported.DidSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SequenceKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sequence uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &sequence)
+		did := exported.Did(iterator.Key()[len(types.SequenceKey):])
+		sequences = append(sequences, exported.NewDidSequence(did, sequence))
+	}
+
+	return sequences
+}
+
+// GetFeeAllowance returns the allowance of a granter for a grantee DID, or
+// for any DID if the grantee is empty
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granterDid, granteeDid exported.Did) (types.FeeAllowance, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granterDid, granteeDid))
+	if bz == nil {
+		return types.FeeAllowance{}, false
+	}
+
+	var allowance types.FeeAllowance
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &allowance)
+	return allowance, true
+}
+
+// SetFeeAllowance stores an allowance, replacing any existing allowance of the
+// granter for the same grantee
+func (k Keeper) SetFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(allowance.GranterDid, allowance.GranteeDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(allowance))
+}
+
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granterDid, granteeDid exported.Did) error {
+	if _, found := k.GetFeeAllowance(ctx, granterDid, granteeDid); !found {
+		return er.Wrapf(exported.ErrorInvalidFeeAllowance, "%s has no allowance for %q", granterDid, granteeDid)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeAllowanceKey(granterDid, granteeDid))
+	return nil
+}
+
+// GetFeeAllowances returns the allowances of a granter, or of all granters if
+// the granter is empty
+func (k Keeper) GetFeeAllowances(ctx sdk.Context, granterDid exported.Did) (allowances types.FeeAllowances) {
+	prefix := types.FeeAllowanceKey
+	if granterDid != "" {
+		prefix = types.GetFeeAllowancePrefixKey(granterDid)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.FeeAllowance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+
+	return allowances
+}
+
+// UseFeeAllowance charges the fee of a tx signed by the grantee DID against an
+// allowance of the granter and returns the account of the granter to deduct
+// the fee from. An allowance for the grantee is used before one for any DID,
+// unless it expired. An allowance that is used up or expired is removed.
+func (k Keeper) UseFeeAllowance(ctx sdk.Context, granterDid, granteeDid exported.Did, msgs []sdk.Msg, fee sdk.Coins) (sdk.AccAddress, error) {
+	granterDoc, err := k.GetDidDoc(ctx, granterDid)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, found := k.getUnexpiredFeeAllowance(ctx, granterDid, granteeDid)
+	if !found || !allowance.AllowsMsgs(msgs) {
+		allowance, found = k.getUnexpiredFeeAllowance(ctx, granterDid, "")
+		if !found || !allowance.AllowsMsgs(msgs) {
+			return nil, er.Wrapf(exported.ErrorInvalidFeeAllowance, "%s has no unexpired allowance for %s covering the tx", granterDid, granteeDid)
+		}
+	}
+
+	remaining, hasNeg := allowance.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return nil, er.Wrapf(exported.ErrorInvalidFeeAllowance, "fee %s exceeds the remaining allowance %s", fee, allowance.SpendLimit)
+	}
+
+	if remaining.IsZero() {
+		_ = k.RevokeFeeAllowance(ctx, allowance.GranterDid, allowance.GranteeDid)
+	} else {
+		allowance.SpendLimit = remaining
+		k.SetFeeAllowance(ctx, allowance)
+	}
+
+	return didDocAccount(granterDoc), nil
+}
+
+// getUnexpiredFeeAllowance returns an allowance of the granter like
+// GetFeeAllowance, but removes it and reports it as not found if it expired
+func (k Keeper) getUnexpiredFeeAllowance(ctx sdk.Context, granterDid, granteeDid exported.Did) (types.FeeAllowance, bool) {
+	allowance, found := k.GetFeeAllowance(ctx, granterDid, granteeDid)
+	if found && allowance.IsExpired(ctx.BlockTime()) {
+		_ = k.RevokeFeeAllowance(ctx, granterDid, granteeDid)
+		return types.FeeAllowance{}, false
+	}
+	return allowance, found
+}
+
+// didDocAccount returns the account that the key of a DID document signs for
+func didDocAccount(didDoc exported.DidDoc) sdk.AccAddress {
+	if baseDidDoc, ok := didDoc.(types.BaseDidDoc); ok && baseDidDoc.KeySet != nil {
+		return baseDidDoc.KeySet.Address()
+	}
+	return didDoc.Address()
+}
+
+// GetTrustedIssuers returns the list of registered trusted issuers
+func (k Keeper) GetTrustedIssuers(ctx sdk.Context) (issuers types.TrustedIssuers) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.IssuerKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var issuer types.TrustedIssuer
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &issuer)
+		issuers = append(issuers, issuer)
+	}
+
+	return issuers
+}
+
+// GetTrustedIssuer returns the trusted issuer entry for a DID if it exists
+func (k Keeper) GetTrustedIssuer(ctx sdk.Context, issuerDid exported.Did) (types.TrustedIssuer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIssuerPrefixKey(issuerDid))
+	if bz == nil {
+		return types.TrustedIssuer{}, false
+	}
+
+	var issuer types.TrustedIssuer
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuer)
+	return issuer, true
+}
+
+// SetTrustedIssuer registers an issuer, replacing any existing entry
+func (k Keeper) SetTrustedIssuer(ctx sdk.Context, issuer types.TrustedIssuer) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetIssuerPrefixKey(issuer.IssuerDid)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(issuer))
+}
+
+// RemoveTrustedIssuer removes an issuer from the registry
+func (k Keeper) RemoveTrustedIssuer(ctx sdk.Context, issuerDid exported.Did) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIssuerPrefixKey(issuerDid))
+}
+
+// IsTrustedIssuerFor checks if an issuer is authorised for all credential types
+func (k Keeper) IsTrustedIssuerFor(ctx sdk.Context, issuerDid exported.Did, credTypes []string) bool {
+	issuer, found := k.GetTrustedIssuer(ctx, issuerDid)
+	if !found {
+		return false
+	}
+	return issuer.IsAuthorisedForAll(credTypes)
+}
+
+// HasValidCredential checks if a DID holds a credential of the given type
+// about itself. If issuers is empty the credential must come from an issuer
+// currently trusted for the type, otherwise from one of the listed issuers.
+func (k Keeper) HasValidCredential(ctx sdk.Context, did exported.Did, credType string, issuers []exported.Did) bool {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return false
+	}
+	baseDidDoc, ok := didDoc.(types.BaseDidDoc)
+	if !ok {
+		return false
+	}
+
+	for _, cred := range baseDidDoc.GetCredentials() {
+		if cred.Claim.Id != did || !cred.HasType(credType) {
+			continue
+		}
+		if len(issuers) == 0 {
+			if k.IsTrustedIssuerFor(ctx, cred.Issuer, []string{credType}) {
+				return true
+			}
+			continue
+		}
+		for _, issuer := range issuers {
+			if cred.Issuer == issuer {
+				return true
+			}
+		}
+	}
+	return false
+}
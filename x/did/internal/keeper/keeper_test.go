@@ -1,9 +1,11 @@
 package keeper
 
 import (
-	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenchain/dp-hub/x/did/internal/types"
@@ -11,7 +13,7 @@ import (
 
 func TestKeeper(t *testing.T) {
 	ctx, k, cdc := CreateTestInput()
-	cdc.RegisterInterface((*did.DidDoc)(nil), nil)
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
 	_, err := k.GetDidDoc(ctx, types.EmptyDid)
 	require.NotNil(t, err)
 
@@ -21,3 +23,238 @@ func TestKeeper(t *testing.T) {
 	_, err = k.GetDidDoc(ctx, types.ValidDidDoc.GetDid())
 	require.Nil(t, err)
 }
+
+func TestKeeperTrustedIssuers(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	issuerDid := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+
+	require.False(t, k.IsTrustedIssuerFor(ctx, issuerDid, []string{types.KYCCredentialType}))
+
+	k.SetTrustedIssuer(ctx, types.NewTrustedIssuer(issuerDid, []string{types.KYCCredentialType}))
+	require.Len(t, k.GetTrustedIssuers(ctx), 1)
+	require.True(t, k.IsTrustedIssuerFor(ctx, issuerDid, []string{types.CredentialType, types.KYCCredentialType}))
+	require.False(t, k.IsTrustedIssuerFor(ctx, issuerDid, []string{types.KYCCredentialType, "ProofOfAge"}))
+
+	k.RemoveTrustedIssuer(ctx, issuerDid)
+	require.Len(t, k.GetTrustedIssuers(ctx), 0)
+	require.False(t, k.IsTrustedIssuerFor(ctx, issuerDid, []string{types.KYCCredentialType}))
+}
+
+func TestKeeperHasValidCredential(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	subjectDid := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	issuerDid := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+	otherDid := "did:dxp:FrNMgb6xmPoVfWoFk5zDGn"
+
+	err := k.SetDidDoc(ctx, types.NewBaseDidDoc(subjectDid, types.ValidDidDoc.PubKey))
+	require.Nil(t, err)
+	require.False(t, k.HasValidCredential(ctx, subjectDid, types.KYCCredentialType, nil))
+
+	credTypes := []string{types.CredentialType, types.KYCCredentialType}
+	msg := types.NewMsgAddCredential(subjectDid, credTypes, issuerDid, "2020-01-01T00:00:00Z", types.KYCClaimSchema, types.KYCClaimBody)
+	err = k.AddCredentials(ctx, subjectDid, msg.DidCredential)
+	require.Nil(t, err)
+
+	// Explicit issuer list
+	require.True(t, k.HasValidCredential(ctx, subjectDid, types.KYCCredentialType, []exported.Did{issuerDid}))
+	require.False(t, k.HasValidCredential(ctx, subjectDid, types.KYCCredentialType, []exported.Did{otherDid}))
+	require.False(t, k.HasValidCredential(ctx, subjectDid, "ProofOfAge", []exported.Did{issuerDid}))
+
+	// A DID that is not registered holds no credentials
+	require.False(t, k.HasValidCredential(ctx, otherDid, types.KYCCredentialType, []exported.Did{issuerDid}))
+
+	// No issuer list falls back to the trusted issuer registry
+	require.False(t, k.HasValidCredential(ctx, subjectDid, types.KYCCredentialType, nil))
+	k.SetTrustedIssuer(ctx, types.NewTrustedIssuer(issuerDid, []string{types.KYCCredentialType}))
+	require.True(t, k.HasValidCredential(ctx, subjectDid, types.KYCCredentialType, nil))
+
+	// Requirements are looked up by message type
+	requirement := exported.NewCredentialRequirement("bonds/create_bond", types.KYCCredentialType, nil)
+	k.SetParams(ctx, types.NewParams([]exported.CredentialRequirement{requirement}))
+	require.Len(t, k.GetCredentialRequirements(ctx, "bonds/create_bond"), 1)
+	require.Len(t, k.GetCredentialRequirements(ctx, "bonds/buy"), 0)
+}
+
+func TestKeeperDidIndexes(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	subjectDid := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	issuerDid := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+
+	didDoc := types.NewBaseDidDoc(subjectDid, types.ValidDidDoc.PubKey)
+	err := k.SetDidDoc(ctx, didDoc)
+	require.Nil(t, err)
+
+	did, found := k.GetDidByAddress(ctx, didDoc.Address())
+	require.True(t, found)
+	require.Equal(t, subjectDid, did)
+	_, found = k.GetDidByAddress(ctx, types.ValidDidDoc.AddressUnverified())
+	require.False(t, found)
+
+	credTypes := []string{types.CredentialType, types.KYCCredentialType}
+	msg := types.NewMsgAddCredential(subjectDid, credTypes, issuerDid, "2020-01-01T00:00:00Z", types.KYCClaimSchema, types.KYCClaimBody)
+	err = k.AddCredentials(ctx, subjectDid, msg.DidCredential)
+	require.Nil(t, err)
+
+	require.Equal(t, []exported.Did{subjectDid}, k.GetDidsByIssuer(ctx, issuerDid, 1, 10))
+	require.Equal(t, []exported.Did{subjectDid}, k.GetDidsByCredType(ctx, types.KYCCredentialType, 1, 10))
+	require.Len(t, k.GetDidsByIssuer(ctx, subjectDid, 1, 10), 0)
+	require.Len(t, k.GetDidsByCredType(ctx, "ProofOfAge", 1, 10), 0)
+	require.Len(t, k.GetDidsByCredType(ctx, types.KYCCredentialType, 2, 10), 0)
+}
+
+func TestKeeperDidsPaginated(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	dids := []exported.Did{
+		"did:dxp:FrNMgb6xmPoVfWoFk5zDGn",
+		"did:dxp:UDH4t88ebNMbdFXAgpwcgD",
+		"did:dxp:VrsU9cUAcYgF7f397xtjsX",
+	}
+	for _, did := range dids {
+		err := k.SetDidDoc(ctx, types.NewBaseDidDoc(did, types.ValidDidDoc.PubKey))
+		require.Nil(t, err)
+	}
+
+	require.Equal(t, dids[:2], k.GetDidsPaginated(ctx, 1, 2))
+	require.Equal(t, dids[2:], k.GetDidsPaginated(ctx, 2, 2))
+	require.Len(t, k.GetDidsPaginated(ctx, 3, 2), 0)
+	require.Len(t, k.GetDidDocsPaginated(ctx, 1, 5), 3)
+	require.Len(t, k.GetDidDocsPaginated(ctx, 0, 5), 0)
+}
+
+func TestKeeperControllers(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	subjectDid := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	controllerDid := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+
+	err := k.SetDidDoc(ctx, types.NewBaseDidDoc(subjectDid, types.ValidDidDoc.PubKey))
+	require.Nil(t, err)
+
+	// The controller needs a DID document of its own
+	require.NotNil(t, k.AddController(ctx, subjectDid, controllerDid))
+	err = k.SetDidDoc(ctx, types.NewBaseDidDoc(controllerDid, types.ValidDidDoc.PubKey))
+	require.Nil(t, err)
+
+	require.Nil(t, k.AddController(ctx, subjectDid, controllerDid))
+	require.True(t, k.IsController(ctx, subjectDid, controllerDid))
+	require.False(t, k.IsController(ctx, controllerDid, subjectDid))
+	require.NotNil(t, k.AddController(ctx, subjectDid, controllerDid))
+
+	require.Nil(t, k.RemoveController(ctx, subjectDid, controllerDid))
+	require.False(t, k.IsController(ctx, subjectDid, controllerDid))
+	require.NotNil(t, k.RemoveController(ctx, subjectDid, controllerDid))
+}
+
+func TestKeeperSetKeySet(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	did := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	keySet := exported.NewMultisigKeySet(1, []string{types.ValidDidDoc.PubKey})
+
+	require.NotNil(t, k.SetKeySet(ctx, did, &keySet))
+	err := k.SetDidDoc(ctx, types.NewBaseDidDoc(did, types.ValidDidDoc.PubKey))
+	require.Nil(t, err)
+
+	require.Nil(t, k.SetKeySet(ctx, did, &keySet))
+	doc, err := k.GetDidDoc(ctx, did)
+	require.Nil(t, err)
+	require.Equal(t, &keySet, doc.(types.BaseDidDoc).GetKeySet())
+
+	// the key set account resolves to the did
+	indexed, found := k.GetDidByAddress(ctx, keySet.Address())
+	require.True(t, found)
+	require.Equal(t, did, indexed)
+
+	// a replaced key set account no longer resolves to the did
+	otherKeySet := exported.NewMultisigKeySet(1, []string{types.ValidDidDoc.PubKey, "FkeDue5B1m84Ebn8J3ZZWb8Q3pLuAmtNjAT1yugxSJyp"})
+	require.Nil(t, k.SetKeySet(ctx, did, &otherKeySet))
+	_, found = k.GetDidByAddress(ctx, keySet.Address())
+	require.False(t, found)
+	indexed, found = k.GetDidByAddress(ctx, otherKeySet.Address())
+	require.True(t, found)
+	require.Equal(t, did, indexed)
+
+	require.Nil(t, k.SetKeySet(ctx, did, nil))
+	doc, _ = k.GetDidDoc(ctx, did)
+	require.Nil(t, doc.(types.BaseDidDoc).GetKeySet())
+	_, found = k.GetDidByAddress(ctx, otherKeySet.Address())
+	require.False(t, found)
+
+	// the did keeps resolving by the address of its own key
+	indexed, found = k.GetDidByAddress(ctx, doc.Address())
+	require.True(t, found)
+	require.Equal(t, did, indexed)
+}
+
+func TestKeeperDidSequences(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	did := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+
+	_, found := k.GetDidSequence(ctx, did)
+	require.False(t, found)
+
+	k.SetDidSequence(ctx, did, 3)
+	sequence, found := k.GetDidSequence(ctx, did)
+	require.True(t, found)
+	require.Equal(t, uint64(3), sequence)
+	require.Equal(t, []exported.DidSequence{exported.NewDidSequence(did, 3)}, k.GetDidSequences(ctx))
+}
+
+func TestKeeperUseFeeAllowance(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	granter := "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+	grantee := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	other := "did:dxp:4XJLBfGtWSGKSz4BeRxdun"
+	addController := []sdk.Msg{types.NewMsgAddController(grantee, other)}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("dap", 4))
+
+	// the granter needs a did doc
+	k.SetFeeAllowance(ctx, types.NewFeeAllowance(granter, grantee, nil, sdk.NewCoins(sdk.NewInt64Coin("dap", 8)), time.Time{}))
+	_, err := k.UseFeeAllowance(ctx, granter, grantee, addController, fee)
+	require.NotNil(t, err)
+	require.Nil(t, k.SetDidDoc(ctx, types.NewBaseDidDoc(granter, types.ValidDidDoc.PubKey)))
+
+	// the spend limit is reduced and the allowance removed once used up
+	_, err = k.UseFeeAllowance(ctx, granter, grantee, addController, fee)
+	require.Nil(t, err)
+	allowance, found := k.GetFeeAllowance(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, fee, allowance.SpendLimit)
+	_, err = k.UseFeeAllowance(ctx, granter, grantee, addController, fee.Add(fee...))
+	require.NotNil(t, err)
+	_, err = k.UseFeeAllowance(ctx, granter, grantee, addController, fee)
+	require.Nil(t, err)
+	_, found = k.GetFeeAllowance(ctx, granter, grantee)
+	require.False(t, found)
+
+	// an allowance for any did is limited to its message types
+	anyDid := types.NewFeeAllowance(granter, "", []string{types.RouterKey + "/" + types.TypeMsgAddController},
+		sdk.NewCoins(sdk.NewInt64Coin("dap", 8)), ctx.BlockTime().Add(time.Hour))
+	k.SetFeeAllowance(ctx, anyDid)
+	_, err = k.UseFeeAllowance(ctx, granter, other, addController, fee)
+	require.Nil(t, err)
+	_, err = k.UseFeeAllowance(ctx, granter, other, []sdk.Msg{types.NewMsgRemoveController(grantee, other)}, fee)
+	require.NotNil(t, err)
+	require.Len(t, k.GetFeeAllowances(ctx, granter), 1)
+
+	// an expired allowance for the grantee is removed and the allowance for
+	// any did is used instead
+	later := ctx.WithBlockTime(anyDid.Expiration.Add(-time.Minute))
+	expired := types.NewFeeAllowance(granter, other, nil, sdk.NewCoins(sdk.NewInt64Coin("dap", 8)), later.BlockTime())
+	k.SetFeeAllowance(ctx, expired)
+	_, err = k.UseFeeAllowance(later, granter, other, addController, sdk.NewCoins(sdk.NewInt64Coin("dap", 1)))
+	require.Nil(t, err)
+	_, found = k.GetFeeAllowance(ctx, granter, other)
+	require.False(t, found)
+	allowance, found = k.GetFeeAllowance(ctx, granter, "")
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dap", 3)), allowance.SpendLimit)
+
+	// an expired allowance cannot be used and is removed
+	_, err = k.UseFeeAllowance(ctx.WithBlockTime(anyDid.Expiration), granter, other, addController, fee)
+	require.NotNil(t, err)
+	require.Empty(t, k.GetFeeAllowances(ctx, granter))
+
+	k.SetFeeAllowance(ctx, anyDid)
+	require.Nil(t, k.RevokeFeeAllowance(ctx, granter, ""))
+	require.NotNil(t, k.RevokeFeeAllowance(ctx, granter, ""))
+	require.Empty(t, k.GetFeeAllowances(ctx, ""))
+}
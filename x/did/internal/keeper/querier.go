@@ -7,12 +7,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/did/internal/types"
+	"strconv"
 )
 
 const (
 	QueryDidDoc     = "queryDidDoc"
 	QueryAllDids    = "queryAllDids"
 	QueryAllDidDocs = "queryAllDidDocs"
+	QueryIssuers    = "queryIssuers"
+
+	QueryDidByAddress   = "queryDidByAddress"
+	QueryDidsByIssuer   = "queryDidsByIssuer"
+	QueryDidsByCredType = "queryDidsByCredType"
+	QueryDidSequence    = "queryDidSequence"
+	QueryFeeAllowances  = "queryFeeAllowances"
+
+	DefaultQueryLimit = 100
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -21,15 +32,66 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryDidDoc:
 			return queryDidDoc(ctx, path[1:], k)
 		case QueryAllDids:
-			return queryAllDids(ctx, k)
+			return queryAllDids(ctx, path[1:], k)
 		case QueryAllDidDocs:
-			return queryAllDidDocs(ctx, k)
+			return queryAllDidDocs(ctx, path[1:], k)
+		case QueryIssuers:
+			return queryIssuers(ctx, k)
+		case QueryDidByAddress:
+			return queryDidByAddress(ctx, path[1:], k)
+		case QueryDidsByIssuer:
+			return queryDidsByIssuer(ctx, path[1:], k)
+		case QueryDidsByCredType:
+			return queryDidsByCredType(ctx, path[1:], k)
+		case QueryDidSequence:
+			return queryDidSequence(ctx, path[1:], k)
+		case QueryFeeAllowances:
+			return queryFeeAllowances(ctx, path[1:], k)
 		default:
 			return nil, exported.UnknownRequest("Unknown did query endpoint")
 		}
 	}
 }
 
+// queryDidSequence returns null for a DID without a stored sequence, which
+// signs with the sequence of its account instead
+func queryDidSequence(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.ErrInvalidDid("did is missing")
+	}
+
+	var didSequence *exported.DidSequence
+	if sequence, found := k.GetDidSequence(ctx, path[0]); found {
+		ds := exported.NewDidSequence(path[0], sequence)
+		didSequence = &ds
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, didSequence)
+	if errRes != nil {
+		return nil, exported.IntErr(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+
+	return res, nil
+}
+
+func queryFeeAllowances(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 || !exported.IsValidDid(path[0]) {
+		return nil, exported.ErrInvalidDid("granter did is missing or invalid")
+	}
+
+	allowances := k.GetFeeAllowances(ctx, path[0])
+	if allowances == nil {
+		allowances = types.FeeAllowances{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, allowances)
+	if errRes != nil {
+		return nil, exported.IntErr(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+
+	return res, nil
+}
+
 func queryDidDoc(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
 	didDoc, err := k.GetDidDoc(ctx, path[0])
 	if err != nil {
@@ -44,8 +106,36 @@ func queryDidDoc(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
-func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, error) {
-	allDids := k.GetAddDids(ctx)
+// parsePagination reads an optional page and limit from the end of a query
+// path. Without both of them all results are returned.
+func parsePagination(path []string) (page, limit int, paginated bool, err error) {
+	if len(path) < 2 {
+		return 0, 0, false, nil
+	}
+
+	page, err = strconv.Atoi(path[0])
+	if err != nil || page < 1 {
+		return 0, 0, false, exported.UnknownRequest(fmt.Sprintf("invalid page '%s'", path[0]))
+	}
+	limit, err = strconv.Atoi(path[1])
+	if err != nil || limit < 1 {
+		return 0, 0, false, exported.UnknownRequest(fmt.Sprintf("invalid limit '%s'", path[1]))
+	}
+	return page, limit, true, nil
+}
+
+func queryAllDids(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	page, limit, paginated, err := parsePagination(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var allDids []exported.Did
+	if paginated {
+		allDids = k.GetDidsPaginated(ctx, page, limit)
+	} else {
+		allDids = k.GetAddDids(ctx)
+	}
 
 	res, errRes := json.Marshal(allDids)
 	if errRes != nil {
@@ -55,9 +145,18 @@ func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
-func queryAllDidDocs(ctx sdk.Context, k Keeper) ([]byte, error) {
+func queryAllDidDocs(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	page, limit, paginated, err := parsePagination(path)
+	if err != nil {
+		return nil, err
+	}
+
 	var didDocs []exported.DidDoc
-	didDocs = k.GetAllDidDocs(ctx)
+	if paginated {
+		didDocs = k.GetDidDocsPaginated(ctx, page, limit)
+	} else {
+		didDocs = k.GetAllDidDocs(ctx)
+	}
 
 	res, errRes := json.Marshal(didDocs)
 	if errRes != nil {
@@ -66,3 +165,71 @@ func queryAllDidDocs(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryIssuers(ctx sdk.Context, k Keeper) ([]byte, error) {
+	issuers := k.GetTrustedIssuers(ctx)
+	res, err := codec.MarshalJSONIndent(k.cdc, issuers)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryDidByAddress(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.UnknownRequest("address is missing from query path")
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, exported.UnknownRequest(fmt.Sprintf("invalid address '%s'", path[0]))
+	}
+
+	did, found := k.GetDidByAddress(ctx, address)
+	if !found {
+		return nil, exported.ErrInvalidDid(fmt.Sprintf("no did found for address %s", path[0]))
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, did)
+	if errRes != nil {
+		return nil, exported.ErrJsonMars(errRes.Error())
+	}
+	return res, nil
+}
+
+func queryDidsByIssuer(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.UnknownRequest("issuer is missing from query path")
+	}
+	page, limit, paginated, err := parsePagination(path[1:])
+	if err != nil {
+		return nil, err
+	} else if !paginated {
+		page, limit = 1, DefaultQueryLimit
+	}
+
+	dids := k.GetDidsByIssuer(ctx, path[0], page, limit)
+	res, errRes := codec.MarshalJSONIndent(k.cdc, dids)
+	if errRes != nil {
+		return nil, exported.ErrJsonMars(errRes.Error())
+	}
+	return res, nil
+}
+
+func queryDidsByCredType(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.UnknownRequest("credential type is missing from query path")
+	}
+	page, limit, paginated, err := parsePagination(path[1:])
+	if err != nil {
+		return nil, err
+	} else if !paginated {
+		page, limit = 1, DefaultQueryLimit
+	}
+
+	dids := k.GetDidsByCredType(ctx, path[0], page, limit)
+	res, errRes := codec.MarshalJSONIndent(k.cdc, dids)
+	if errRes != nil {
+		return nil, exported.ErrJsonMars(errRes.Error())
+	}
+	return res, nil
+}
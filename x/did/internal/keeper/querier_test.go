@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,7 +12,7 @@ import (
 
 func TestQueryDidDocs(t *testing.T) {
 	ctx, k, cdc := CreateTestInput()
-	cdc.RegisterInterface((*did.DidDoc)(nil), nil)
+	cdc.RegisterInterface((*exported.DidDoc)(nil), nil)
 	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
 	require.Nil(t, err)
 
@@ -41,3 +41,46 @@ func TestQueryDidDocs(t *testing.T) {
 	_, _ = cdc.MarshalJSONIndent(b, "", " ")
 
 }
+
+func TestQueryDidsPaginated(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	err := k.SetDidDoc(ctx, &types.ValidDidDoc)
+	require.Nil(t, err)
+
+	querier := NewQuerier(k)
+	res, err := querier(ctx, []string{QueryAllDids, "1", "1"}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var dids []exported.Did
+	require.Nil(t, cdc.UnmarshalJSON(res, &dids))
+	require.Equal(t, []exported.Did{types.ValidDidDoc.Did}, dids)
+
+	_, err = querier(ctx, []string{QueryAllDids, "0", "1"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+
+	res, err = querier(ctx, []string{QueryDidByAddress, types.ValidDidDoc.Address().String()}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var did exported.Did
+	require.Nil(t, cdc.UnmarshalJSON(res, &did))
+	require.Equal(t, types.ValidDidDoc.Did, did)
+}
+
+func TestQueryDidSequence(t *testing.T) {
+	ctx, k, cdc := CreateTestInput()
+	did := "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	querier := NewQuerier(k)
+
+	// a did without a stored sequence signs with its account sequence
+	res, err := querier(ctx, []string{QueryDidSequence, did}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var didSequence *exported.DidSequence
+	require.Nil(t, cdc.UnmarshalJSON(res, &didSequence))
+	require.Nil(t, didSequence)
+
+	k.SetDidSequence(ctx, did, 2)
+	res, err = querier(ctx, []string{QueryDidSequence, did}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(res, &didSequence))
+	require.Equal(t, exported.NewDidSequence(did, 2), *didSequence)
+}
@@ -7,8 +7,14 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddDid{}, "did/MsgAddDid", nil)
 	cdc.RegisterConcrete(MsgAddCredential{}, "did/MsgAddCredential", nil)
+	cdc.RegisterConcrete(MsgAddController{}, "did/MsgAddController", nil)
+	cdc.RegisterConcrete(MsgRemoveController{}, "did/MsgRemoveController", nil)
+	cdc.RegisterConcrete(MsgSetKeySet{}, "did/MsgSetKeySet", nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "did/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "did/MsgRevokeFeeAllowance", nil)
 	// TODO: https://github.com/tokenchain/dp-hub/issues/76
 	cdc.RegisterConcrete(BaseDidDoc{}, "did/BaseDidDoc", nil)
+	cdc.RegisterConcrete(TrustedIssuerProposal{}, "did/TrustedIssuerProposal", nil)
 	//cdc.RegisterConcrete(ante.IxoTx{}, "darkpool/IxoTx", nil)
 	//cdc.RegisterConcrete(DidCredential{}, "did/DidCredential", nil)
 
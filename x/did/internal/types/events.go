@@ -0,0 +1,23 @@
+package types
+
+const (
+	EventTypeTrustedIssuer      = "trusted_issuer"
+	EventTypeAddController      = "add_controller"
+	EventTypeRemoveController   = "remove_controller"
+	EventTypeSetKeySet          = "set_key_set"
+	EventTypeGrantFeeAllowance  = "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance = "revoke_fee_allowance"
+
+	AttributeKeyIssuerDid     = "issuer_did"
+	AttributeKeyCredTypes     = "cred_types"
+	AttributeKeyDid           = "did"
+	AttributeKeyControllerDid = "controller_did"
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeyGranterDid    = "granter_did"
+	AttributeKeyGranteeDid    = "grantee_did"
+	AttributeKeyMsgTypes      = "msg_types"
+	AttributeKeySpendLimit    = "spend_limit"
+	AttributeKeyExpiration    = "expiration"
+
+	AttributeValueCategory = ModuleName
+)
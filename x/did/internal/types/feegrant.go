@@ -0,0 +1,84 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	er "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// --------------------------------------- FeeAllowance/s
+
+type (
+	// FeeAllowance lets a granter DID pay the fees of txs signed by a grantee
+	// DID, or by any DID if no grantee is set, until the spend limit is used
+	// up or the allowance expires. If message types are set, only txs made
+	// up of those message types are paid for.
+	FeeAllowance struct {
+		GranterDid exported.Did `json:"granter_did" yaml:"granter_did"`
+		GranteeDid exported.Did `json:"grantee_did,omitempty" yaml:"grantee_did"`
+		MsgTypes   []string     `json:"msg_types,omitempty" yaml:"msg_types"`
+		SpendLimit sdk.Coins    `json:"spend_limit" yaml:"spend_limit"`
+		Expiration time.Time    `json:"expiration,omitempty" yaml:"expiration"` // zero never expires
+	}
+	FeeAllowances []FeeAllowance
+)
+
+func NewFeeAllowance(granterDid, granteeDid exported.Did, msgTypes []string,
+	spendLimit sdk.Coins, expiration time.Time) FeeAllowance {
+	return FeeAllowance{
+		GranterDid: granterDid,
+		GranteeDid: granteeDid,
+		MsgTypes:   msgTypes,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+func (fa FeeAllowance) Validate() error {
+	if !exported.IsValidDid(fa.GranterDid) {
+		return er.Wrap(exported.ErrorInvalidDidE, "granter did is invalid")
+	} else if fa.GranteeDid != "" && !exported.IsValidDid(fa.GranteeDid) {
+		return er.Wrap(exported.ErrorInvalidDidE, "grantee did is invalid")
+	} else if fa.GranteeDid == fa.GranterDid {
+		return er.Wrap(exported.ErrorInvalidFeeAllowance, "granter cannot grant itself")
+	} else if fa.GranteeDid == "" && len(fa.MsgTypes) == 0 {
+		return er.Wrap(exported.ErrorInvalidFeeAllowance, "an allowance for any did must be limited to message types")
+	} else if !fa.SpendLimit.IsValid() || fa.SpendLimit.IsZero() {
+		return er.Wrapf(exported.ErrorInvalidFeeAllowance, "invalid spend limit %s", fa.SpendLimit)
+	}
+
+	for _, msgType := range fa.MsgTypes {
+		if len(strings.Split(msgType, "/")) != 2 {
+			return er.Wrapf(exported.ErrorInvalidFeeAllowance, "message type %s must be of the form <route>/<type>", msgType)
+		}
+	}
+	return nil
+}
+
+// IsExpired returns true if the allowance cannot be used at the block time
+func (fa FeeAllowance) IsExpired(blockTime time.Time) bool {
+	return !fa.Expiration.IsZero() && !blockTime.Before(fa.Expiration)
+}
+
+// AllowsMsgs returns true if the allowance pays for a tx with the messages
+func (fa FeeAllowance) AllowsMsgs(msgs []sdk.Msg) bool {
+	if len(fa.MsgTypes) == 0 {
+		return true
+	}
+	for _, msg := range msgs {
+		allowed := false
+		for _, msgType := range fa.MsgTypes {
+			if msgType == exported.MsgTypeKey(msg) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeAllowanceValidation(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("dap", 10))
+	msgTypes := []string{RouterKey + "/" + TypeMsgAddDid}
+
+	require.Nil(t, NewFeeAllowance(testIssuerDid, testSubjectDid, nil, limit, time.Time{}).Validate())
+	require.Nil(t, NewFeeAllowance(testIssuerDid, "", msgTypes, limit, time.Time{}).Validate())
+	require.NotNil(t, NewFeeAllowance("", testSubjectDid, nil, limit, time.Time{}).Validate())
+	require.NotNil(t, NewFeeAllowance(testIssuerDid, testIssuerDid, nil, limit, time.Time{}).Validate())
+	require.NotNil(t, NewFeeAllowance(testIssuerDid, "", nil, limit, time.Time{}).Validate())
+	require.NotNil(t, NewFeeAllowance(testIssuerDid, testSubjectDid, nil, sdk.Coins{}, time.Time{}).Validate())
+	require.NotNil(t, NewFeeAllowance(testIssuerDid, testSubjectDid, []string{"add-did"}, limit, time.Time{}).Validate())
+
+	require.Nil(t, NewMsgGrantFeeAllowance(NewFeeAllowance(testIssuerDid, testSubjectDid, nil, limit, time.Time{})).ValidateBasic())
+	require.Nil(t, NewMsgRevokeFeeAllowance(testIssuerDid, "").ValidateBasic())
+	require.NotNil(t, NewMsgRevokeFeeAllowance(testIssuerDid, "abc").ValidateBasic())
+}
+
+func TestFeeAllowanceUse(t *testing.T) {
+	expiration := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	allowance := NewFeeAllowance(testIssuerDid, "", []string{RouterKey + "/" + TypeMsgAddController},
+		sdk.NewCoins(sdk.NewInt64Coin("dap", 10)), expiration)
+
+	require.False(t, allowance.IsExpired(expiration.Add(-time.Second)))
+	require.True(t, allowance.IsExpired(expiration))
+	require.False(t, NewFeeAllowance(testIssuerDid, testSubjectDid, nil, allowance.SpendLimit, time.Time{}).IsExpired(expiration))
+
+	require.True(t, allowance.AllowsMsgs([]sdk.Msg{NewMsgAddController(testSubjectDid, testIssuerDid)}))
+	require.False(t, allowance.AllowsMsgs([]sdk.Msg{
+		NewMsgAddController(testSubjectDid, testIssuerDid),
+		NewMsgRemoveController(testSubjectDid, testIssuerDid),
+	}))
+}
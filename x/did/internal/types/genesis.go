@@ -1,26 +1,54 @@
 package types
 
 import (
+	er "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 )
 
 type GenesisState struct {
-	DidDocs []exported.DidDoc `json:"did_docs" yaml:"did_docs"`
+	DidDocs        []exported.DidDoc `json:"did_docs" yaml:"did_docs"`
+	TrustedIssuers TrustedIssuers    `json:"trusted_issuers" yaml:"trusted_issuers"`
+	Params         Params            `json:"params" yaml:"params"`
+	// Sequences are kept across exports so that txs signed before the export
+	// cannot be replayed on the new chain
+	Sequences     []exported.DidSequence `json:"sequences" yaml:"sequences"`
+	FeeAllowances FeeAllowances          `json:"fee_allowances" yaml:"fee_allowances"`
 }
 
-func NewGenesisState(didDocs []exported.DidDoc) GenesisState {
+func NewGenesisState(didDocs []exported.DidDoc, trustedIssuers TrustedIssuers, params Params) GenesisState {
 	return GenesisState{
-		DidDocs: didDocs,
+		DidDocs:        didDocs,
+		TrustedIssuers: trustedIssuers,
+		Params:         params,
 	}
 }
 
-//noinspection GoUnusedParameter
 func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+	for _, ti := range data.TrustedIssuers {
+		if err := ti.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, ds := range data.Sequences {
+		if !exported.IsValidDid(ds.Did) {
+			return er.Wrapf(exported.ErrorInvalidDidE, "invalid did %s in sequences", ds.Did)
+		}
+	}
+	for _, fa := range data.FeeAllowances {
+		if err := fa.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		DidDocs: nil,
+		DidDocs:        nil,
+		TrustedIssuers: nil,
+		Params:         DefaultParams(),
 	}
 }
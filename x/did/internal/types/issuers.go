@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	er "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// --------------------------------------- TrustedIssuer/s
+
+type (
+	TrustedIssuer struct {
+		IssuerDid exported.Did `json:"issuer_did" yaml:"issuer_did"`
+		CredTypes []string     `json:"cred_types" yaml:"cred_types"`
+	}
+	TrustedIssuers []TrustedIssuer
+)
+
+func NewTrustedIssuer(issuerDid exported.Did, credTypes []string) TrustedIssuer {
+	return TrustedIssuer{
+		IssuerDid: issuerDid,
+		CredTypes: credTypes,
+	}
+}
+
+// IsAuthorisedFor returns true if the issuer may issue credentials of the
+// given type. The generic base credential type is implied for every issuer.
+func (ti TrustedIssuer) IsAuthorisedFor(credType string) bool {
+	if credType == CredentialType {
+		return true
+	}
+	for _, t := range ti.CredTypes {
+		if t == credType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAuthorisedForAll returns true if the issuer may issue a credential
+// carrying all of the given types.
+func (ti TrustedIssuer) IsAuthorisedForAll(credTypes []string) bool {
+	for _, t := range credTypes {
+		if !ti.IsAuthorisedFor(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ti TrustedIssuer) Validate() error {
+	if !exported.IsValidDid(ti.IssuerDid) {
+		return exported.ErrInvalidDid(fmt.Sprintf("issuer did %s is invalid", ti.IssuerDid))
+	}
+	for _, t := range ti.CredTypes {
+		if strings.TrimSpace(t) == "" {
+			return er.Wrapf(exported.ErrorInvalidIssuer, "issuer %s has a blank credential type", ti.IssuerDid)
+		}
+	}
+	return nil
+}
+
+func (tis TrustedIssuers) Includes(issuer TrustedIssuer) bool {
+	for _, ti := range tis {
+		if ti.IssuerDid == issuer.IssuerDid {
+			return true
+		}
+	}
+	return false
+}
+
+func ParseCredTypes(credTypesStr string) ([]string, error) {
+	credTypesStr = strings.TrimSpace(credTypesStr)
+	if len(credTypesStr) == 0 {
+		return nil, nil
+	}
+
+	credTypesStrs := strings.Split(credTypesStr, ",")
+	credTypes := make([]string, len(credTypesStrs))
+	for i, t := range credTypesStrs {
+		t = strings.TrimSpace(t)
+		if len(t) == 0 {
+			return nil, fmt.Errorf("invalid empty credential type: %s", credTypesStr)
+		}
+		credTypes[i] = t
+	}
+
+	return credTypes, nil
+}
@@ -1,16 +1,83 @@
 package types
 
-import "github.com/tokenchain/dp-hub/x/did/exported"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
 
 const (
-	ModuleName   = "did"
-	StoreKey     = ModuleName
-	RouterKey    = ModuleName
-	QuerierRoute = ModuleName
+	ModuleName        = "did"
+	DefaultParamspace = ModuleName
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
 )
 
-var DidKey = []byte{0x01}
+var (
+	DidKey           = []byte{0x01}
+	IssuerKey        = []byte{0x02}
+	AddressKey       = []byte{0x03}
+	IssuerIndexKey   = []byte{0x04}
+	CredTypeIndexKey = []byte{0x05}
+	SequenceKey      = []byte{0x06}
+	FeeAllowanceKey  = []byte{0x07}
+)
 
 func GetDidPrefixKey(did exported.Did) []byte {
 	return append(DidKey, []byte(did)...)
 }
+
+func GetIssuerPrefixKey(issuerDid exported.Did) []byte {
+	return append(IssuerKey, []byte(issuerDid)...)
+}
+
+func GetSequencePrefixKey(did exported.Did) []byte {
+	return append(SequenceKey, []byte(did)...)
+}
+
+func GetAddressPrefixKey(address sdk.AccAddress) []byte {
+	return append(AddressKey, address.Bytes()...)
+}
+
+// lengthPrefixed returns the string preceded by its length as a uvarint, so
+// that one such string can never be a prefix of another, whatever its length
+func lengthPrefixed(s string) []byte {
+	bz := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(s))
+	n := binary.PutUvarint(bz, uint64(len(s)))
+	return append(bz[:n], s...)
+}
+
+// GetIssuerIndexPrefixKey returns the prefix under which all DIDs holding a
+// credential from the issuer are indexed. The issuer is length-prefixed so
+// that one issuer can never be a prefix of another.
+func GetIssuerIndexPrefixKey(issuerDid exported.Did) []byte {
+	return append(IssuerIndexKey, lengthPrefixed(issuerDid)...)
+}
+
+func GetIssuerIndexKey(issuerDid exported.Did, did exported.Did) []byte {
+	return append(GetIssuerIndexPrefixKey(issuerDid), []byte(did)...)
+}
+
+// GetCredTypeIndexPrefixKey returns the prefix under which all DIDs holding a
+// credential of the type are indexed.
+func GetCredTypeIndexPrefixKey(credType string) []byte {
+	return append(CredTypeIndexKey, lengthPrefixed(credType)...)
+}
+
+func GetCredTypeIndexKey(credType string, did exported.Did) []byte {
+	return append(GetCredTypeIndexPrefixKey(credType), []byte(did)...)
+}
+
+// GetFeeAllowancePrefixKey returns the prefix under which all allowances of a
+// granter are stored
+func GetFeeAllowancePrefixKey(granterDid exported.Did) []byte {
+	return append(FeeAllowanceKey, lengthPrefixed(granterDid)...)
+}
+
+// GetFeeAllowanceKey returns the key of an allowance. An allowance for any DID
+// has an empty grantee.
+func GetFeeAllowanceKey(granterDid exported.Did, granteeDid exported.Did) []byte {
+	return append(GetFeeAllowancePrefixKey(granterDid), []byte(granteeDid)...)
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLengthPrefixedKeys(t *testing.T) {
+	// 300 bytes would wrap around to the length of 44 bytes in a single byte
+	long := strings.Repeat("a", 300)
+	short := strings.Repeat("a", 44)
+
+	prefixKeys := []func(string) []byte{
+		GetIssuerIndexPrefixKey,
+		GetCredTypeIndexPrefixKey,
+		GetFeeAllowancePrefixKey,
+	}
+	for _, prefixKey := range prefixKeys {
+		require.False(t, bytes.HasPrefix(prefixKey(long), prefixKey(short)))
+		require.False(t, bytes.HasPrefix(prefixKey(short), prefixKey(long)))
+		require.False(t, bytes.HasPrefix(prefixKey("ab"), prefixKey("a")))
+	}
+
+	// the key of a DID is under the prefix of its issuer only
+	require.True(t, bytes.HasPrefix(GetIssuerIndexKey(long, "did"), GetIssuerIndexPrefixKey(long)))
+	require.False(t, bytes.HasPrefix(GetIssuerIndexKey(long, "did"), GetIssuerIndexPrefixKey(short)))
+}
@@ -14,11 +14,23 @@ import (
 const (
 	TypeMsgAddDid        = "add-did"
 	TypeMsgAddCredential = "add-credential"
+
+	TypeMsgAddController    = "add-controller"
+	TypeMsgRemoveController = "remove-controller"
+	TypeMsgSetKeySet        = "set-key-set"
+
+	TypeMsgGrantFeeAllowance  = "grant-fee-allowance"
+	TypeMsgRevokeFeeAllowance = "revoke-fee-allowance"
 )
 
 var (
 	_ ante.IxoMsg = MsgAddDid{}
 	_ ante.IxoMsg = MsgAddCredential{}
+	_ ante.IxoMsg = MsgAddController{}
+	_ ante.IxoMsg = MsgRemoveController{}
+	_ ante.IxoMsg = MsgSetKeySet{}
+	_ ante.IxoMsg = MsgGrantFeeAllowance{}
+	_ ante.IxoMsg = MsgRevokeFeeAllowance{}
 )
 
 type MsgAddDid struct {
@@ -42,9 +54,11 @@ func (msg *MsgAddDid) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
-func NewMsgAddDid(did string, publicKey string) MsgAddDid {
+func NewMsgAddDid(did string, publicKey string, keyType string) MsgAddDid {
+	didDoc := NewBaseDidDoc(did, publicKey)
+	didDoc.KeyType = keyType
 	return MsgAddDid{
-		DidDoc: NewBaseDidDoc(did, publicKey),
+		DidDoc: didDoc,
 	}
 }
 
@@ -62,6 +76,25 @@ func (msg MsgAddDid) ValidateBasic() error {
 		return er.Wrap(exported.ErrorInvalidPubKey, "pubKey should not be empty")
 	}
 
+	// Check the key against its type if one is declared
+	if msg.DidDoc.KeyType != "" {
+		if err := exported.ValidateVerifyKey(msg.DidDoc.PubKey, msg.DidDoc.KeyType); err != nil {
+			return err
+		}
+	}
+
+	// Check the multisig key set if one is declared
+	if msg.DidDoc.KeySet != nil {
+		if err := msg.DidDoc.KeySet.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Controllers are added through MsgAddController once the DID exists
+	if len(msg.DidDoc.Controllers) > 0 {
+		return er.Wrap(exported.ErrorInvalidController, "cannot add a new DID with controllers")
+	}
+
 	// Check DidDoc credentials for empty fields
 	for _, cred := range msg.DidDoc.Credentials {
 		if strings.TrimSpace(cred.Issuer) == "" {
@@ -95,15 +128,12 @@ type MsgAddCredential struct {
 	DidCredential exported.DidCredential `json:"credential" yaml:"credential"`
 }
 
-func NewMsgAddCredential(did string, credType []string, issuer string, issued string) MsgAddCredential {
+func NewMsgAddCredential(did string, credType []string, issuer string, issued string, schema string, body json.RawMessage) MsgAddCredential {
 	didCredential := exported.DidCredential{
 		CredType: credType,
 		Issuer:   issuer,
 		Issued:   issued,
-		Claim: exported.Claim{
-			Id:           did,
-			KYCValidated: true,
-		},
+		Claim:    exported.NewClaim(did, schema, body),
 	}
 
 	return MsgAddCredential{
@@ -131,7 +161,19 @@ func (msg MsgAddCredential) ValidateBasic() error {
 	if !exported.IsValidDid(msg.DidCredential.Issuer) {
 		return er.Wrap(exported.ErrorInvalidDidE, "issuer id is invalid")
 	}
-	return nil
+	// Check credential types
+	if len(msg.DidCredential.CredType) == 0 {
+		return er.Wrap(exported.ErrorInvalidClaim, "credential type should not be empty")
+	}
+	for _, credType := range msg.DidCredential.CredType {
+		if strings.TrimSpace(credType) == "" {
+			return er.Wrap(exported.ErrorInvalidClaim, "credential type should not be blank")
+		} else if len(credType) > exported.MaxCredTypeLength {
+			return er.Wrapf(exported.ErrorInvalidClaim, "credential type exceeds %d characters", exported.MaxCredTypeLength)
+		}
+	}
+	// Check claim schema and body
+	return msg.DidCredential.Claim.Validate()
 }
 func (msg MsgAddCredential) GetSignBytes() []byte {
 	if bz, err := json.Marshal(msg); err != nil {
@@ -140,3 +182,188 @@ func (msg MsgAddCredential) GetSignBytes() []byte {
 		return sdk.MustSortJSON(bz)
 	}
 }
+
+type MsgAddController struct {
+	Did           exported.Did `json:"did" yaml:"did"`
+	ControllerDid exported.Did `json:"controllerDid" yaml:"controllerDid"`
+}
+
+func NewMsgAddController(did exported.Did, controllerDid exported.Did) MsgAddController {
+	return MsgAddController{
+		Did:           did,
+		ControllerDid: controllerDid,
+	}
+}
+
+func (msg MsgAddController) Type() string               { return TypeMsgAddController }
+func (msg MsgAddController) Route() string              { return RouterKey }
+func (msg MsgAddController) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgAddController) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+func (msg MsgAddController) String() string {
+	return fmt.Sprintf("MsgAddController{Did: %v, Controller: %v}", msg.Did, msg.ControllerDid)
+}
+func (msg MsgAddController) ValidateBasic() error {
+	return validateControllerDids(msg.Did, msg.ControllerDid)
+}
+func (msg MsgAddController) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+type MsgRemoveController struct {
+	Did           exported.Did `json:"did" yaml:"did"`
+	ControllerDid exported.Did `json:"controllerDid" yaml:"controllerDid"`
+}
+
+func NewMsgRemoveController(did exported.Did, controllerDid exported.Did) MsgRemoveController {
+	return MsgRemoveController{
+		Did:           did,
+		ControllerDid: controllerDid,
+	}
+}
+
+func (msg MsgRemoveController) Type() string               { return TypeMsgRemoveController }
+func (msg MsgRemoveController) Route() string              { return RouterKey }
+func (msg MsgRemoveController) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgRemoveController) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+func (msg MsgRemoveController) String() string {
+	return fmt.Sprintf("MsgRemoveController{Did: %v, Controller: %v}", msg.Did, msg.ControllerDid)
+}
+func (msg MsgRemoveController) ValidateBasic() error {
+	return validateControllerDids(msg.Did, msg.ControllerDid)
+}
+func (msg MsgRemoveController) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+func validateControllerDids(did exported.Did, controllerDid exported.Did) error {
+	if !exported.IsValidDid(did) {
+		return er.Wrap(exported.ErrorInvalidDidE, "did is invalid")
+	} else if !exported.IsValidDid(controllerDid) {
+		return er.Wrap(exported.ErrorInvalidController, "controller did is invalid")
+	} else if did == controllerDid {
+		return er.Wrap(exported.ErrorInvalidController, "a did cannot be its own controller")
+	}
+	return nil
+}
+
+// MsgSetKeySet declares the multisig key set of a DID, or removes it if the
+// key set is nil. Once set, the DID is signed for by the key set.
+type MsgSetKeySet struct {
+	Did    exported.Did             `json:"did" yaml:"did"`
+	KeySet *exported.MultisigKeySet `json:"keySet,omitempty" yaml:"keySet"`
+}
+
+func NewMsgSetKeySet(did exported.Did, keySet *exported.MultisigKeySet) MsgSetKeySet {
+	return MsgSetKeySet{
+		Did:    did,
+		KeySet: keySet,
+	}
+}
+
+func (msg MsgSetKeySet) Type() string               { return TypeMsgSetKeySet }
+func (msg MsgSetKeySet) Route() string              { return RouterKey }
+func (msg MsgSetKeySet) GetSignerDid() exported.Did { return msg.Did }
+func (msg MsgSetKeySet) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+func (msg MsgSetKeySet) String() string {
+	return fmt.Sprintf("MsgSetKeySet{Did: %v, KeySet: %v}", msg.Did, msg.KeySet)
+}
+func (msg MsgSetKeySet) ValidateBasic() error {
+	if !exported.IsValidDid(msg.Did) {
+		return er.Wrap(exported.ErrorInvalidDidE, "did is invalid")
+	} else if msg.KeySet != nil {
+		return msg.KeySet.Validate()
+	}
+	return nil
+}
+func (msg MsgSetKeySet) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgGrantFeeAllowance lets the granter DID pay the fees of txs that name it
+// as fee granter, replacing any allowance it granted the same grantee before
+type MsgGrantFeeAllowance struct {
+	Allowance FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+func NewMsgGrantFeeAllowance(allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Allowance: allowance,
+	}
+}
+
+func (msg MsgGrantFeeAllowance) Type() string               { return TypeMsgGrantFeeAllowance }
+func (msg MsgGrantFeeAllowance) Route() string              { return RouterKey }
+func (msg MsgGrantFeeAllowance) GetSignerDid() exported.Did { return msg.Allowance.GranterDid }
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+func (msg MsgGrantFeeAllowance) String() string {
+	return fmt.Sprintf("MsgGrantFeeAllowance{Allowance: %v}", msg.Allowance)
+}
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	return msg.Allowance.Validate()
+}
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgRevokeFeeAllowance removes the allowance of the granter DID for the
+// grantee DID, or for any DID if the grantee is empty
+type MsgRevokeFeeAllowance struct {
+	GranterDid exported.Did `json:"granterDid" yaml:"granterDid"`
+	GranteeDid exported.Did `json:"granteeDid,omitempty" yaml:"granteeDid"`
+}
+
+func NewMsgRevokeFeeAllowance(granterDid, granteeDid exported.Did) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		GranterDid: granterDid,
+		GranteeDid: granteeDid,
+	}
+}
+
+func (msg MsgRevokeFeeAllowance) Type() string               { return TypeMsgRevokeFeeAllowance }
+func (msg MsgRevokeFeeAllowance) Route() string              { return RouterKey }
+func (msg MsgRevokeFeeAllowance) GetSignerDid() exported.Did { return msg.GranterDid }
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+func (msg MsgRevokeFeeAllowance) String() string {
+	return fmt.Sprintf("MsgRevokeFeeAllowance{GranterDid: %v, GranteeDid: %v}", msg.GranterDid, msg.GranteeDid)
+}
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if !exported.IsValidDid(msg.GranterDid) {
+		return er.Wrap(exported.ErrorInvalidDidE, "granter did is invalid")
+	} else if msg.GranteeDid != "" && !exported.IsValidDid(msg.GranteeDid) {
+		return er.Wrap(exported.ErrorInvalidDidE, "grantee did is invalid")
+	}
+	return nil
+}
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const (
+	testSubjectDid = "did:dxp:UDH4t88ebNMbdFXAgpwcgD"
+	testIssuerDid  = "did:dxp:VrsU9cUAcYgF7f397xtjsX"
+	testIssued     = "2020-01-01T00:00:00Z"
+)
+
+func TestMsgAddCredentialValidation(t *testing.T) {
+	credTypes := []string{CredentialType, "ProofOfAge"}
+	longBody := json.RawMessage(`{"data":"` + strings.Repeat("a", 5000) + `"}`)
+
+	cases := []struct {
+		valid bool
+		msg   MsgAddCredential
+	}{
+		{true, NewMsgAddCredential(testSubjectDid, credTypes, testIssuerDid, testIssued, "AgeSchema", json.RawMessage(`{"over18":true}`))},
+		{true, NewMsgAddCredential(testSubjectDid, []string{KYCCredentialType}, testIssuerDid, testIssued, KYCClaimSchema, KYCClaimBody)},
+		{true, NewMsgAddCredential(testSubjectDid, credTypes, testIssuerDid, testIssued, "AgeSchema", nil)},
+		{false, NewMsgAddCredential(testSubjectDid, nil, testIssuerDid, testIssued, "AgeSchema", nil)},
+		{false, NewMsgAddCredential(testSubjectDid, []string{" "}, testIssuerDid, testIssued, "AgeSchema", nil)},
+		{false, NewMsgAddCredential(testSubjectDid, credTypes, testIssuerDid, testIssued, "", nil)},
+		{false, NewMsgAddCredential(testSubjectDid, credTypes, testIssuerDid, testIssued, "AgeSchema", json.RawMessage(`{"over18":`))},
+		{false, NewMsgAddCredential(testSubjectDid, credTypes, testIssuerDid, testIssued, "AgeSchema", longBody)},
+		{false, NewMsgAddCredential("", credTypes, testIssuerDid, testIssued, "AgeSchema", nil)},
+		{false, NewMsgAddCredential(testSubjectDid, credTypes, "", testIssued, "AgeSchema", nil)},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+}
+
+func TestDidCredentialDuplicates(t *testing.T) {
+	cred := NewMsgAddCredential(testSubjectDid, []string{KYCCredentialType}, testIssuerDid, testIssued, KYCClaimSchema, KYCClaimBody).DidCredential
+	same := NewMsgAddCredential(testSubjectDid, []string{KYCCredentialType}, testIssuerDid, testIssued, KYCClaimSchema, KYCClaimBody).DidCredential
+	moreTypes := NewMsgAddCredential(testSubjectDid, []string{CredentialType, KYCCredentialType}, testIssuerDid, testIssued, KYCClaimSchema, KYCClaimBody).DidCredential
+	otherBody := NewMsgAddCredential(testSubjectDid, []string{KYCCredentialType}, testIssuerDid, testIssued, KYCClaimSchema, json.RawMessage(`{"KYCValidated":false}`)).DidCredential
+	noTypes := NewMsgAddCredential(testSubjectDid, nil, testIssuerDid, testIssued, KYCClaimSchema, KYCClaimBody).DidCredential
+
+	require.True(t, cred.Duplicates(same))
+	require.False(t, cred.Duplicates(moreTypes))
+	require.False(t, cred.Duplicates(otherBody))
+	require.False(t, cred.Duplicates(noTypes))
+	require.False(t, noTypes.Duplicates(cred))
+}
+
+func TestMsgControllerValidation(t *testing.T) {
+	require.Nil(t, NewMsgAddController(testSubjectDid, testIssuerDid).ValidateBasic())
+	require.Nil(t, NewMsgRemoveController(testSubjectDid, testIssuerDid).ValidateBasic())
+	require.NotNil(t, NewMsgAddController(testSubjectDid, testSubjectDid).ValidateBasic())
+	require.NotNil(t, NewMsgAddController(testSubjectDid, "").ValidateBasic())
+	require.NotNil(t, NewMsgRemoveController("", testIssuerDid).ValidateBasic())
+}
+
+func TestMsgSetKeySetValidation(t *testing.T) {
+	pubKey := ValidDidDoc.PubKey
+	otherPubKey := "FkeDue5P2KAxWatKqrbPvmTX9vwWrzngDTqqMtmJ4q7Y"
+
+	valid := exported.NewMultisigKeySet(1, []string{pubKey, otherPubKey})
+	noKeys := exported.NewMultisigKeySet(1, nil)
+	noThreshold := exported.NewMultisigKeySet(0, []string{pubKey})
+	highThreshold := exported.NewMultisigKeySet(3, []string{pubKey, otherPubKey})
+	duplicate := exported.NewMultisigKeySet(1, []string{pubKey, pubKey})
+	badKey := exported.NewMultisigKeySet(1, []string{"abc"})
+
+	require.Nil(t, NewMsgSetKeySet(testSubjectDid, &valid).ValidateBasic())
+	require.Nil(t, NewMsgSetKeySet(testSubjectDid, nil).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet("", &valid).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet(testSubjectDid, &noKeys).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet(testSubjectDid, &noThreshold).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet(testSubjectDid, &highThreshold).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet(testSubjectDid, &duplicate).ValidateBasic())
+	require.NotNil(t, NewMsgSetKeySet(testSubjectDid, &badKey).ValidateBasic())
+}
+
+func TestMsgAddDidKeyType(t *testing.T) {
+	edDid := exported.NewDidGeneratorBuilder().Build()
+	secpDid := exported.NewDidGeneratorBuilder().WithKeyType(exported.KeyTypeSecp256k1).Build()
+
+	require.Nil(t, NewMsgAddDid(edDid.Did, edDid.VerifyKey, edDid.KeyType).ValidateBasic())
+	require.Nil(t, NewMsgAddDid(edDid.Did, edDid.VerifyKey, exported.KeyTypeEd25519).ValidateBasic())
+	require.Nil(t, NewMsgAddDid(secpDid.Did, secpDid.VerifyKey, secpDid.KeyType).ValidateBasic())
+	require.NotNil(t, NewMsgAddDid(secpDid.Did, secpDid.VerifyKey, exported.KeyTypeEd25519).ValidateBasic())
+	require.NotNil(t, NewMsgAddDid(edDid.Did, edDid.VerifyKey, exported.KeyTypeSecp256k1).ValidateBasic())
+	require.NotNil(t, NewMsgAddDid(edDid.Did, edDid.VerifyKey, "rsa").ValidateBasic())
+
+	// the address of a did doc is derived from its key of the declared type
+	msg := NewMsgAddDid(secpDid.Did, secpDid.VerifyKey, secpDid.KeyType)
+	require.Equal(t, secpDid.Address(), msg.DidDoc.Address())
+	require.Equal(t, exported.KeyTypeSecp256k1, msg.DidDoc.GetKeyType())
+	require.Equal(t, exported.KeyTypeEd25519, NewMsgAddDid(edDid.Did, edDid.VerifyKey, "").DidDoc.GetKeyType())
+}
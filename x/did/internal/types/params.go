@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// Parameter store keys
+var (
+	KeyCredentialRequirements = []byte("CredentialRequirements")
+)
+
+// did parameters
+type Params struct {
+	CredentialRequirements []exported.CredentialRequirement `json:"credential_requirements" yaml:"credential_requirements"`
+}
+
+// ParamTable for did module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(credentialRequirements []exported.CredentialRequirement) Params {
+	return Params{
+		CredentialRequirements: credentialRequirements,
+	}
+}
+
+// default did module parameters
+func DefaultParams() Params {
+	return Params{
+		CredentialRequirements: []exported.CredentialRequirement{},
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	return validateCredentialRequirements(params.CredentialRequirements)
+}
+
+func (p Params) String() string {
+	var b strings.Builder
+	b.WriteString("Did Params:\n  Credential Requirements:\n")
+	for _, cr := range p.CredentialRequirements {
+		b.WriteString(fmt.Sprintf("    %s: %s %v\n", cr.MsgType, cr.CredType, cr.Issuers))
+	}
+	return b.String()
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyCredentialRequirements, &p.CredentialRequirements, validateCredentialRequirements),
+	}
+}
+
+func validateCredentialRequirements(i interface{}) error {
+	requirements, ok := i.([]exported.CredentialRequirement)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, cr := range requirements {
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTrustedIssuer defines the type for a TrustedIssuerProposal
+	ProposalTypeTrustedIssuer = "TrustedIssuer"
+)
+
+var _ govtypes.Content = TrustedIssuerProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTrustedIssuer)
+	govtypes.RegisterProposalTypeCodec(TrustedIssuerProposal{}, "did/TrustedIssuerProposal")
+}
+
+// TrustedIssuerProposal sets the credential types an issuer DID is trusted
+// for. An empty list of credential types removes the issuer from the registry.
+type TrustedIssuerProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Issuer      TrustedIssuer `json:"issuer" yaml:"issuer"`
+}
+
+func NewTrustedIssuerProposal(title, description string, issuer TrustedIssuer) TrustedIssuerProposal {
+	return TrustedIssuerProposal{
+		Title:       title,
+		Description: description,
+		Issuer:      issuer,
+	}
+}
+
+func (tip TrustedIssuerProposal) GetTitle() string       { return tip.Title }
+func (tip TrustedIssuerProposal) GetDescription() string { return tip.Description }
+func (tip TrustedIssuerProposal) ProposalRoute() string  { return RouterKey }
+func (tip TrustedIssuerProposal) ProposalType() string   { return ProposalTypeTrustedIssuer }
+
+func (tip TrustedIssuerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(tip); err != nil {
+		return err
+	}
+	return tip.Issuer.Validate()
+}
+
+func (tip TrustedIssuerProposal) String() string {
+	return fmt.Sprintf(`Trusted Issuer Proposal:
+  Title:       %s
+  Description: %s
+  Issuer:      %s
+  Cred Types:  %v
+`, tip.Title, tip.Description, tip.Issuer.IssuerDid, tip.Issuer.CredTypes)
+}
@@ -10,10 +10,25 @@ import (
 
 var _ exported.DidDoc = (*BaseDidDoc)(nil)
 
+// Credential types and claim used by the KYC shortcut commands
+const (
+	CredentialType    = "Credential"
+	KYCCredentialType = "ProofOfKYC"
+	KYCClaimSchema    = "ProofOfKYC"
+)
+
+var KYCClaimBody = json.RawMessage(`{"KYCValidated":true}`)
+
+// MaxControllers is the maximum number of controllers a DID document can list
+const MaxControllers = 16
+
 type BaseDidDoc struct {
 	Did         exported.Did             `json:"did" yaml:"did"`
-	PubKey      string                   `json:"pubKey" yaml:"pubKey"` //that also is the verify key
+	PubKey      string                   `json:"pubKey" yaml:"pubKey"`             //that also is the verify key
+	KeyType     string                   `json:"keyType,omitempty" yaml:"keyType"` // of PubKey, ed25519 if empty
 	Credentials []exported.DidCredential `json:"credentials,omitempty" yaml:"credentials"`
+	Controllers []exported.Did           `json:"controllers,omitempty" yaml:"controllers"` // DIDs that may sign on behalf of this DID
+	KeySet      *exported.MultisigKeySet `json:"keySet,omitempty" yaml:"keySet"`           // k-of-n keys that sign instead of PubKey
 }
 
 func NewBaseDidDoc(did exported.Did, pubKey string) BaseDidDoc {
@@ -25,7 +40,10 @@ func NewBaseDidDoc(did exported.Did, pubKey string) BaseDidDoc {
 }
 func (dd BaseDidDoc) GetDid() exported.Did                     { return dd.Did }
 func (dd BaseDidDoc) GetPubKey() string                        { return dd.PubKey }
+func (dd BaseDidDoc) GetKeyType() string                       { return exported.KeyTypeOrDefault(dd.KeyType) }
 func (dd BaseDidDoc) GetCredentials() []exported.DidCredential { return dd.Credentials }
+func (dd BaseDidDoc) GetControllers() []exported.Did           { return dd.Controllers }
+func (dd BaseDidDoc) GetKeySet() *exported.MultisigKeySet      { return dd.KeySet }
 func (dd BaseDidDoc) SetDid(did exported.Did) error {
 	if len(dd.Did) != 0 {
 		return errors.New("cannot override BaseDidDoc did")
@@ -46,13 +64,35 @@ func (dd *BaseDidDoc) AddCredential(cred exported.DidCredential) {
 	}
 	dd.Credentials = append(dd.Credentials, cred)
 }
+func (dd BaseDidDoc) HasController(did exported.Did) bool {
+	for _, controller := range dd.Controllers {
+		if controller == did {
+			return true
+		}
+	}
+	return false
+}
+func (dd *BaseDidDoc) AddController(did exported.Did) {
+	dd.Controllers = append(dd.Controllers, did)
+}
+func (dd *BaseDidDoc) RemoveController(did exported.Did) {
+	controllers := make([]exported.Did, 0, len(dd.Controllers))
+	for _, controller := range dd.Controllers {
+		if controller != did {
+			controllers = append(controllers, controller)
+		}
+	}
+	dd.Controllers = controllers
+}
 func (dd BaseDidDoc) Address() sdk.AccAddress {
-	return exported.VerifyKeyToAddrEd25519(dd.GetPubKey())
+	return exported.VerifyKeyToAddress(dd.GetPubKey(), dd.KeyType)
 }
 func (dd BaseDidDoc) AddressUnverified() sdk.AccAddress {
 	return exported.UnverifiedToAddr(dd.GetPubKey())
 }
+
 type Credential struct{}
+
 func fromJsonString(jsonIxoDid string) (exported.IxoDid, error) {
 	var did exported.IxoDid
 	err := json.Unmarshal([]byte(jsonIxoDid), &did)
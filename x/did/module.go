@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/tokenchain/dp-hub/x/did/ante"
 	"github.com/tokenchain/dp-hub/x/did/client/cli"
 	"github.com/tokenchain/dp-hub/x/did/client/rest"
 	"github.com/tokenchain/dp-hub/x/did/internal/keeper"
@@ -63,9 +64,19 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	didTxCmd.AddCommand(flags.PostCommands(
 		cli.GetCmdAddDidDoc(cdc),
 		cli.GetCmdAddCredential(cdc),
+		cli.GetCmdAddGenericCredential(cdc),
+		cli.GetCmdAddController(cdc),
+		cli.GetCmdRemoveController(cdc),
+		cli.GetCmdMultiMsgTx(cdc),
+		cli.GetCmdSetKeySet(cdc),
+		cli.GetCmdSignPartial(cdc),
+		cli.GetCmdCombineSignatures(cdc),
+		cli.GetCmdGrantFeeAllowance(cdc),
+		cli.GetCmdRevokeFeeAllowance(cdc),
   	//	cli.GetCmdDidGenerate(cdc),
 		cli.GetCmdAccDidGenerate(cdc),
 	)...)
+	didTxCmd.PersistentFlags().String(ante.FlagFeeGranter, "", "Did whose fee allowance pays the fee")
 
 	return didTxCmd
 }
@@ -84,6 +95,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		cli.GetCmdDidDoc(cdc),
 		cli.GetCmdAllDids(cdc),
 		cli.GetCmdAllDidDocs(cdc),
+		cli.GetCmdTrustedIssuers(cdc),
+		cli.GetCmdDidByAddress(cdc),
+		cli.GetCmdDidSequence(cdc),
+		cli.GetCmdFeeAllowances(cdc),
+		cli.GetCmdDidsByIssuer(cdc),
+		cli.GetCmdDidsByCredType(cdc),
 	)...)
 
 	return didQueryCmd
@@ -11,9 +11,46 @@ const (
 	RouterKey    = types.RouterKey
 	StoreKey     = types.StoreKey
 
-	MintCap     = types.MintCap
-	BurnCap     = types.BurnCap
-	TransferCap = types.TransferCap
+	DefaultParamspace = types.DefaultParamspace
+
+	MintCap       = types.MintCap
+	BurnCap       = types.BurnCap
+	TransferCap   = types.TransferCap
+	ComplianceCap = types.ComplianceCap
+	PriceFeedCap  = types.PriceFeedCap
+
+	ProofSchemeNone    = types.ProofSchemeNone
+	ProofSchemeEd25519 = types.ProofSchemeEd25519
+
+	ProposalTypeAddOracle    = types.ProposalTypeAddOracle
+	ProposalTypeUpdateOracle = types.ProposalTypeUpdateOracle
+	ProposalTypeRemoveOracle = types.ProposalTypeRemoveOracle
+	ProposalTypeSlashOracle  = types.ProposalTypeSlashOracle
+
+	EventTypeAddOracle       = types.EventTypeAddOracle
+	EventTypeUpdateOracle    = types.EventTypeUpdateOracle
+	EventTypeRemoveOracle    = types.EventTypeRemoveOracle
+	EventTypeSubmitPrice     = types.EventTypeSubmitPrice
+	EventTypeExchangeRate    = types.EventTypeExchangeRate
+	EventTypeBondOracle      = types.EventTypeBondOracle
+	EventTypeUnbondOracle    = types.EventTypeUnbondOracle
+	EventTypeUnbonded        = types.EventTypeUnbonded
+	EventTypeSlashOracle     = types.EventTypeSlashOracle
+	AttributeKeyOracleDid    = types.AttributeKeyOracleDid
+	AttributeKeyCapabilities = types.AttributeKeyCapabilities
+	AttributeKeyProofScheme  = types.AttributeKeyProofScheme
+	AttributeKeyBase         = types.AttributeKeyBase
+	AttributeKeyQuote        = types.AttributeKeyQuote
+	AttributeKeyPrice        = types.AttributeKeyPrice
+	AttributeKeyRate         = types.AttributeKeyRate
+	AttributeKeyVotes        = types.AttributeKeyVotes
+	AttributeKeyAmount       = types.AttributeKeyAmount
+	AttributeKeyFraction     = types.AttributeKeyFraction
+	AttributeKeyCompletion   = types.AttributeKeyCompletion
+
+	TypeMsgSubmitPrice  = types.TypeMsgSubmitPrice
+	TypeMsgBondOracle   = types.TypeMsgBondOracle
+	TypeMsgUnbondOracle = types.TypeMsgUnbondOracle
 
 	//DefaultCodespace = types.DefaultCodespace
 )
@@ -28,6 +65,22 @@ type (
 	OracleTokenCaps = types.OracleTokenCaps
 	TokenCap        = types.TokenCap
 	TokenCaps       = types.TokenCaps
+	ProofScheme     = types.ProofScheme
+	TokenLimit      = types.TokenLimit
+	Params          = types.Params
+	PriceVote       = types.PriceVote
+	ExchangeRate    = types.ExchangeRate
+	OracleBond      = types.OracleBond
+	Unbonding       = types.Unbonding
+
+	MsgSubmitPrice  = types.MsgSubmitPrice
+	MsgBondOracle   = types.MsgBondOracle
+	MsgUnbondOracle = types.MsgUnbondOracle
+
+	AddOracleProposal    = types.AddOracleProposal
+	UpdateOracleProposal = types.UpdateOracleProposal
+	RemoveOracleProposal = types.RemoveOracleProposal
+	SlashOracleProposal  = types.SlashOracleProposal
 )
 
 var (
@@ -36,6 +89,36 @@ var (
 	NewQuerier    = keeper.NewQuerier
 	RegisterCodec = types.RegisterCodec
 
+	NewOracle                = types.NewOracle
+	NewOracleWithProofScheme = types.NewOracleWithProofScheme
+	NewTokenLimit            = types.NewTokenLimit
+	ParseOracleTokenCaps     = types.ParseOracleTokenCaps
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	ValidateParams           = types.ValidateParams
+	NewPriceVote             = types.NewPriceVote
+	NewExchangeRate          = types.NewExchangeRate
+	ValidatePair             = types.ValidatePair
+	MedianPrice              = types.MedianPrice
+	NewMsgSubmitPrice        = types.NewMsgSubmitPrice
+	NewOracleBond            = types.NewOracleBond
+	NewUnbonding             = types.NewUnbonding
+	NewMsgBondOracle         = types.NewMsgBondOracle
+	NewMsgUnbondOracle       = types.NewMsgUnbondOracle
+
+	NewAddOracleProposal    = types.NewAddOracleProposal
+	NewUpdateOracleProposal = types.NewUpdateOracleProposal
+	NewRemoveOracleProposal = types.NewRemoveOracleProposal
+	NewSlashOracleProposal  = types.NewSlashOracleProposal
+
+	ErrInvalidOracle        = types.ErrInvalidOracle
+	ErrOracleNotFound       = types.ErrOracleNotFound
+	ErrOracleAlreadyExists  = types.ErrOracleAlreadyExists
+	ErrInvalidPriceVote     = types.ErrInvalidPriceVote
+	ErrExchangeRateNotFound = types.ErrExchangeRateNotFound
+	ErrInvalidBond          = types.ErrInvalidBond
+	ErrInsufficientBond     = types.ErrInsufficientBond
+
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
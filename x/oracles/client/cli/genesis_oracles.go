@@ -13,7 +13,11 @@ import (
 )
 
 const (
-	flagClientHome = "home-client"
+	flagClientHome  = "home-client"
+	flagProofScheme = "proof-scheme"
+	flagProofKey    = "proof-key"
+	flagMintLimits  = "mint-limits"
+	flagBurnLimits  = "burn-limits"
 )
 
 func AddGenesisOracleCmd(ctx *server.Context, cdc *codec.Codec,
@@ -37,12 +41,27 @@ func AddGenesisOracleCmd(ctx *server.Context, cdc *codec.Codec,
 				return err
 			}
 
+			// Attach the mint and burn limits to the capabilities
+			if err := setLimits(capabilities, types.MintCap, viper.GetString(flagMintLimits)); err != nil {
+				return err
+			} else if err := setLimits(capabilities, types.BurnCap, viper.GetString(flagBurnLimits)); err != nil {
+				return err
+			}
+
 			// Check that oracle DID is valid
 			if !exported.IsValidDid(oracleDid) {
 				return fmt.Errorf("oracle did is invalid")
 			}
 
-			oracle := types.NewOracle(oracleDid, capabilities)
+			oracle := types.NewOracleWithProofScheme(oracleDid, capabilities,
+				types.ProofScheme(viper.GetString(flagProofScheme)), viper.GetString(flagProofKey))
+
+			// Check that the proof scheme and the limits are valid
+			if err := oracle.ValidateProofScheme(); err != nil {
+				return err
+			} else if err := oracle.ValidateLimits(); err != nil {
+				return err
+			}
 
 			// retrieve the app state
 			genFile := config.GenesisFile()
@@ -79,5 +98,36 @@ func AddGenesisOracleCmd(ctx *server.Context, cdc *codec.Codec,
 
 	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
 	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	cmd.Flags().String(flagProofScheme, "", "scheme that the oracle's proofs are checked with (ed25519), none if empty")
+	cmd.Flags().String(flagProofKey, "", "base58 key that the oracle's proofs are checked against")
+	cmd.Flags().String(flagMintLimits, "", "mint limits of the form [denom]=[max-per-tx]/[max-per-window]/[window-blocks][,...]")
+	cmd.Flags().String(flagBurnLimits, "", "burn limits of the form [denom]=[max-per-tx]/[max-per-window]/[window-blocks][,...]")
 	return cmd
 }
+
+// setLimits parses the limits on minting or burning and attaches them to the
+// capabilities of their denoms
+func setLimits(capabilities types.OracleTokenCaps, cap types.TokenCap, limitsStr string) error {
+	limits, err := types.ParseTokenLimits(limitsStr)
+	if err != nil {
+		return err
+	}
+
+	for denom, limit := range limits {
+		if !capabilities.Includes(denom) {
+			return fmt.Errorf("%s limit on %s without a capability", cap, denom)
+		}
+		for i := range capabilities {
+			if capabilities[i].Denom != denom {
+				continue
+			}
+			limit := limit
+			if cap == types.MintCap {
+				capabilities[i].MintLimit = &limit
+			} else {
+				capabilities[i].BurnLimit = &limit
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+// GetCmdSubmitAddOracleProposal implements a command handler for submitting
+// an add oracle proposal transaction.
+func GetCmdSubmitAddOracleProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-oracle [oracle-did] [capability][,[capability]] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to register an oracle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an add oracle proposal along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal add-oracle did:dxp:VrsU9cUAcYgF7f397xtjsX dap:mint/burn "Bridge" "Register the bridge oracle" 1000mdap --mint-limits=dap=1000/10000/100 --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			capabilities, err := parseCapabilities(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			oracle := types.NewOracleWithProofScheme(exported.Did(args[0]), capabilities,
+				types.ProofScheme(viper.GetString(flagProofScheme)), viper.GetString(flagProofKey))
+			content := types.NewAddOracleProposal(args[2], args[3], oracle)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	addProofFlags(cmd)
+	addLimitFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUpdateOracleProposal implements a command handler for submitting
+// an update oracle proposal transaction.
+func GetCmdSubmitUpdateOracleProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-oracle [oracle-did] [capability][,[capability]] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to replace the capabilities and proof scheme of an oracle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update oracle proposal along with an initial deposit.
+The capabilities, limits, proof scheme and proof key replace all of the oracle's
+current ones, so an oracle updated without --proof-scheme no longer has its
+proofs checked.
+
+Example:
+$ %s tx gov submit-proposal update-oracle did:dxp:VrsU9cUAcYgF7f397xtjsX dap:mint "Bridge" "Stop the bridge oracle burning" 1000mdap --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			capabilities, err := parseCapabilities(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateOracleProposal(args[2], args[3], exported.Did(args[0]), capabilities,
+				types.ProofScheme(viper.GetString(flagProofScheme)), viper.GetString(flagProofKey))
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	addProofFlags(cmd)
+	addLimitFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitRemoveOracleProposal implements a command handler for submitting
+// a remove oracle proposal transaction.
+func GetCmdSubmitRemoveOracleProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-oracle [oracle-did] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to remove an oracle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a remove oracle proposal along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal remove-oracle did:dxp:VrsU9cUAcYgF7f397xtjsX "Bridge" "Retire the bridge oracle" 1000mdap --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			deposit, err := sdk.ParseCoins(args[3])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveOracleProposal(args[1], args[2], exported.Did(args[0]))
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitSlashOracleProposal implements a command handler for submitting
+// a slash oracle proposal transaction.
+func GetCmdSubmitSlashOracleProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "slash-oracle [oracle-did] [fraction] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to burn a fraction of the stake of an oracle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a slash oracle proposal along with an initial deposit. The fraction
+is burnt from both the bonded and the unbonding stake of the oracle.
+
+Example:
+$ %s tx gov submit-proposal slash-oracle did:dxp:VrsU9cUAcYgF7f397xtjsX 0.1 "Bridge" "Slash the bridge oracle for an invalid mint proof" 1000mdap --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			fraction, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSlashOracleProposal(args[2], args[3], exported.Did(args[0]), fraction)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func addProofFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagProofScheme, "", "scheme that the oracle's proofs are checked with (ed25519), none if empty")
+	cmd.Flags().String(flagProofKey, "", "base58 key that the oracle's proofs are checked against")
+}
+
+func addLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMintLimits, "", "mint limits of the form [denom]=[max-per-tx]/[max-per-window]/[window-blocks][,...]")
+	cmd.Flags().String(flagBurnLimits, "", "burn limits of the form [denom]=[max-per-tx]/[max-per-window]/[window-blocks][,...]")
+}
+
+// parseCapabilities parses the capabilities and attaches the mint and burn
+// limits given in the flags to them
+func parseCapabilities(capsStr string) (types.OracleTokenCaps, error) {
+	capabilities, err := types.ParseOracleTokenCaps(capsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setLimits(capabilities, types.MintCap, viper.GetString(flagMintLimits)); err != nil {
+		return nil, err
+	} else if err := setLimits(capabilities, types.BurnCap, viper.GetString(flagBurnLimits)); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
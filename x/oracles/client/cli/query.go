@@ -35,3 +35,145 @@ func GetOraclesRequestHandler(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetParamsRequestHandler(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query params, including the price vote window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+				keeper.QueryParams)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func GetCmdExchangeRate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rate [base] [quote]",
+		Short: "Query the exchange rate of a currency pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryExchangeRate, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			var rate types.ExchangeRate
+			if err := cdc.UnmarshalJSON(bz, &rate); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(rate)
+		},
+	}
+}
+
+func GetCmdExchangeRates(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rates",
+		Short: "Query the exchange rates of every currency pair",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+				keeper.QueryExchangeRates)
+			if err != nil {
+				return err
+			}
+
+			var rates []types.ExchangeRate
+			if err := cdc.UnmarshalJSON(bz, &rates); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(rates)
+		},
+	}
+}
+
+func GetCmdPriceVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-votes [base] [quote]",
+		Short: "Query the price vote history of a currency pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryPriceVotes, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			var votes []types.PriceVote
+			if err := cdc.UnmarshalJSON(bz, &votes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(votes)
+		},
+	}
+}
+
+func GetCmdOracleBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bond [oracle-did]",
+		Short: "Query the stake that an oracle has bonded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryOracleBond, args[0])
+			if err != nil {
+				return err
+			}
+
+			var bond types.OracleBond
+			if err := cdc.UnmarshalJSON(bz, &bond); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(bond)
+		},
+	}
+}
+
+func GetCmdUnbondings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbondings [oracle-did]",
+		Short: "Query the stake that an oracle is unbonding",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryUnbondings, args[0])
+			if err != nil {
+				return err
+			}
+
+			var unbondings []types.Unbonding
+			if err := cdc.UnmarshalJSON(bz, &unbondings); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(unbondings)
+		},
+	}
+}
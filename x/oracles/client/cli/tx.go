@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func GetCmdSubmitPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit-price [base] [quote] [price] [oracle-dap-did]",
+		Short: "Vote on the price of the base denom in the quote denom as an oracle",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			base := args[0]
+			quote := args[1]
+			priceStr := args[2]
+			ixoDidStr := args[3]
+
+			price, err := sdk.NewDecFromStr(priceStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSubmitPrice(base, quote, price, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdBondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bond [amount] [oracle-dap-did]",
+		Short: "Bond stake as an oracle, which is needed to register and to operate",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amountStr := args[0]
+			ixoDidStr := args[1]
+
+			amount, err := sdk.ParseCoin(amountStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgBondOracle(amount, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdUnbondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbond [amount] [oracle-dap-did]",
+		Short: "Unbond stake as an oracle, which is paid out after the unbonding period",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amountStr := args[0]
+			ixoDidStr := args[1]
+
+			amount, err := sdk.ParseCoin(amountStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgUnbondOracle(amount, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tokenchain/dp-hub/x/oracles/client/cli"
+	"github.com/tokenchain/dp-hub/x/oracles/client/rest"
+)
+
+var (
+	// AddOracleProposalHandler handles add oracle proposals
+	AddOracleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitAddOracleProposal, rest.AddOracleProposalRESTHandler)
+	// UpdateOracleProposalHandler handles update oracle proposals
+	UpdateOracleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateOracleProposal, rest.UpdateOracleProposalRESTHandler)
+	// RemoveOracleProposalHandler handles remove oracle proposals
+	RemoveOracleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveOracleProposal, rest.RemoveOracleProposalRESTHandler)
+	// SlashOracleProposalHandler handles slash oracle proposals
+	SlashOracleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSlashOracleProposal, rest.SlashOracleProposalRESTHandler)
+)
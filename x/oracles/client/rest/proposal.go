@@ -0,0 +1,175 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+type AddOracleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Oracle      types.Oracle   `json:"oracle" yaml:"oracle"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+type UpdateOracleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title        string                `json:"title" yaml:"title"`
+	Description  string                `json:"description" yaml:"description"`
+	OracleDid    exported.Did          `json:"oracle_did" yaml:"oracle_did"`
+	Capabilities types.OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+	ProofScheme  types.ProofScheme     `json:"proof_scheme" yaml:"proof_scheme"`
+	ProofKey     string                `json:"proof_key" yaml:"proof_key"`
+	Proposer     sdk.AccAddress        `json:"proposer" yaml:"proposer"`
+	Deposit      sdk.Coins             `json:"deposit" yaml:"deposit"`
+}
+
+type RemoveOracleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	OracleDid   exported.Did   `json:"oracle_did" yaml:"oracle_did"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+type SlashOracleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	OracleDid   exported.Did   `json:"oracle_did" yaml:"oracle_did"`
+	Fraction    sdk.Dec        `json:"fraction" yaml:"fraction"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// AddOracleProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// add oracle REST handler with a given sub-route.
+func AddOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_oracle",
+		Handler:  postAddOracleProposalHandlerFn(cliCtx),
+	}
+}
+
+// UpdateOracleProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the update oracle REST handler with a given sub-route.
+func UpdateOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_oracle",
+		Handler:  postUpdateOracleProposalHandlerFn(cliCtx),
+	}
+}
+
+// RemoveOracleProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the remove oracle REST handler with a given sub-route.
+func RemoveOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_oracle",
+		Handler:  postRemoveOracleProposalHandlerFn(cliCtx),
+	}
+}
+
+// SlashOracleProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the slash oracle REST handler with a given sub-route.
+func SlashOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "slash_oracle",
+		Handler:  postSlashOracleProposalHandlerFn(cliCtx),
+	}
+}
+
+func postAddOracleProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddOracleProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewAddOracleProposal(req.Title, req.Description, req.Oracle)
+		writeProposal(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postUpdateOracleProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateOracleProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateOracleProposal(req.Title, req.Description, req.OracleDid, req.Capabilities,
+			req.ProofScheme, req.ProofKey)
+		writeProposal(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postRemoveOracleProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveOracleProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRemoveOracleProposal(req.Title, req.Description, req.OracleDid)
+		writeProposal(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postSlashOracleProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SlashOracleProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSlashOracleProposal(req.Title, req.Description, req.OracleDid, req.Fraction)
+		writeProposal(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+	}
+}
+
+func writeProposal(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq,
+	content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	msg := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
@@ -15,6 +15,24 @@ import (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/oracles", queryOraclesRequestHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/oracles/params",
+		queryParamsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/oracles/rates",
+		queryExchangeRatesHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/oracles/rates/{%s}/{%s}", RestBase, RestQuote),
+		queryExchangeRateHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/oracles/rates/{%s}/{%s}/votes", RestBase, RestQuote),
+		queryPriceVotesHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/oracles/bonds/{%s}", RestOracleDid),
+		queryOracleBondHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/oracles/bonds/{%s}/unbondings", RestOracleDid),
+		queryUnbondingsHandler(cliCtx)).Methods("GET")
 }
 
 func queryOraclesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -38,3 +56,125 @@ func queryOraclesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, oracles)
 	}
 }
+
+func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var params types.Params
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
+
+func queryExchangeRateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryExchangeRate, vars[RestBase], vars[RestQuote])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		var rate types.ExchangeRate
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &rate); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, rate)
+	}
+}
+
+func queryExchangeRatesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryExchangeRates)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var rates []types.ExchangeRate
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &rates); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, rates)
+	}
+}
+
+func queryPriceVotesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryPriceVotes, vars[RestBase], vars[RestQuote])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var votes []types.PriceVote
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &votes); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, votes)
+	}
+}
+
+func queryOracleBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryOracleBond, vars[RestOracleDid])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var bond types.OracleBond
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &bond); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, bond)
+	}
+}
+
+func queryUnbondingsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryUnbondings, vars[RestOracleDid])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var unbondings []types.Unbonding
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &unbondings); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, unbondings)
+	}
+}
@@ -5,6 +5,13 @@ import (
 	"github.com/gorilla/mux"
 )
 
+const (
+	RestBase      = "base"
+	RestQuote     = "quote"
+	RestOracleDid = "oracle_did"
+)
+
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(cliCtx, r)
 	registerQueryRoutes(cliCtx, r)
 }
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+
+	"github.com/tokenchain/dp-hub/x/dap"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func writeHead(w http.ResponseWriter, code int, txt string) {
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(txt))
+}
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/oracles/submitPrice", submitPriceRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/bond", bondOracleRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/oracles/unbond", unbondOracleRequestHandler(cliCtx)).Methods("POST")
+}
+
+func submitPriceRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		baseParam := r.URL.Query().Get("base")
+		quoteParam := r.URL.Query().Get("quote")
+		priceParam := r.URL.Query().Get("price")
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		price, err := sdk.NewDecFromStr(priceParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		oracleDid, err := exported.UnmarshalDxpDid(oracleDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSubmitPrice(baseParam, quoteParam, price, oracleDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, oracleDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func bondOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		amountParam := r.URL.Query().Get("amount")
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		amount, err := sdk.ParseCoin(amountParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		oracleDid, err := exported.UnmarshalDxpDid(oracleDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBondOracle(amount, oracleDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, oracleDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func unbondOracleRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		amountParam := r.URL.Query().Get("amount")
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		amount, err := sdk.ParseCoin(amountParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		oracleDid, err := exported.UnmarshalDxpDid(oracleDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnbondOracle(amount, oracleDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, oracleDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
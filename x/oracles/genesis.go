@@ -14,10 +14,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			keeper.SetOracle(ctx, o)
 		}
 	}
+
+	// Init params
+	keeper.SetParams(ctx, data.Params)
+
+	// Init exchange rates and the price votes of the current window
+	for _, rate := range data.ExchangeRates {
+		keeper.SetExchangeRate(ctx, rate)
+	}
+	for _, vote := range data.PriceVotes {
+		keeper.SetPriceVote(ctx, vote)
+	}
+	keeper.SetNextVoteId(ctx, data.NextVoteId)
+
+	// Init bonds and unbondings, which are held by the module account
+	for _, bond := range data.Bonds {
+		keeper.SetOracleBond(ctx, bond.OracleDid, bond.Amount)
+	}
+	for _, unbonding := range data.Unbondings {
+		keeper.SetUnbonding(ctx, unbonding)
+	}
+	keeper.SetNextUnbondingId(ctx, data.NextUnbondingId)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	oracles := keeper.GetOracles(ctx)
-	return NewGenesisState(oracles)
+	return NewGenesisState(keeper.GetOracles(ctx), keeper.GetParams(ctx),
+		keeper.GetExchangeRates(ctx), keeper.GetRetainedPriceVotes(ctx), keeper.GetNextVoteId(ctx),
+		keeper.GetOracleBonds(ctx), keeper.GetUnbondings(ctx, ""), keeper.GetNextUnbondingId(ctx))
 }
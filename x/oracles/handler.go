@@ -0,0 +1,203 @@
+package oracles
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+// EndBlocker aggregates the price votes into exchange rates at the end of
+// every vote window and pays out the completed unbondings
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	for _, rate := range k.AggregateExchangeRates(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExchangeRate,
+				sdk.NewAttribute(types.AttributeKeyBase, rate.Base),
+				sdk.NewAttribute(types.AttributeKeyQuote, rate.Quote),
+				sdk.NewAttribute(types.AttributeKeyRate, rate.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyVotes, strconv.FormatUint(rate.Votes, 10)),
+			),
+		)
+	}
+
+	bondDenom := k.GetParams(ctx).BondDenom
+	for _, unbonding := range k.CompleteUnbondings(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbonded,
+				sdk.NewAttribute(types.AttributeKeyOracleDid, unbonding.OracleDid),
+				sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(bondDenom, unbonding.Amount).String()),
+			),
+		)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgSubmitPrice:
+			return handleMsgSubmitPrice(ctx, k, msg)
+		case MsgBondOracle:
+			return handleMsgBondOracle(ctx, k, msg)
+		case MsgUnbondOracle:
+			return handleMsgUnbondOracle(ctx, k, msg)
+		default:
+			return nil, exported.UnknownRequest("No match for message type.")
+		}
+	}
+}
+
+func handleMsgSubmitPrice(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitPrice) (*sdk.Result, error) {
+	if _, err := k.SubmitPrice(ctx, msg.OracleDid, msg.Base, msg.Quote, msg.Price); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitPrice,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, msg.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyBase, msg.Base),
+			sdk.NewAttribute(types.AttributeKeyQuote, msg.Quote),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBondOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgBondOracle) (*sdk.Result, error) {
+	if err := k.Bond(ctx, msg.OracleDid, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBondOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, msg.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnbondOracle(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbondOracle) (*sdk.Result, error) {
+	unbonding, err := k.Unbond(ctx, msg.OracleDid, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbondOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, msg.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletion, unbonding.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func NewOracleProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.AddOracleProposal:
+			return handleAddOracleProposal(ctx, k, c)
+		case types.UpdateOracleProposal:
+			return handleUpdateOracleProposal(ctx, k, c)
+		case types.RemoveOracleProposal:
+			return handleRemoveOracleProposal(ctx, k, c)
+		case types.SlashOracleProposal:
+			return handleSlashOracleProposal(ctx, k, c)
+		default:
+			return exported.UnknownRequest(fmt.Sprintf("unrecognized oracles proposal content type: %T", c))
+		}
+	}
+}
+
+func handleAddOracleProposal(ctx sdk.Context, k keeper.Keeper, p types.AddOracleProposal) error {
+	if err := k.AddOracle(ctx, p.Oracle); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, p.Oracle.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyCapabilities, p.Oracle.Capabilities.String()),
+			sdk.NewAttribute(types.AttributeKeyProofScheme, string(p.Oracle.ProofScheme)),
+		),
+	)
+	return nil
+}
+
+func handleUpdateOracleProposal(ctx sdk.Context, k keeper.Keeper, p types.UpdateOracleProposal) error {
+	oracle := p.GetOracle()
+	if err := k.UpdateOracle(ctx, oracle); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, oracle.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyCapabilities, oracle.Capabilities.String()),
+			sdk.NewAttribute(types.AttributeKeyProofScheme, string(oracle.ProofScheme)),
+		),
+	)
+	return nil
+}
+
+func handleRemoveOracleProposal(ctx sdk.Context, k keeper.Keeper, p types.RemoveOracleProposal) error {
+	if err := k.RemoveOracle(ctx, p.OracleDid); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, p.OracleDid),
+		),
+	)
+	return nil
+}
+
+func handleSlashOracleProposal(ctx sdk.Context, k keeper.Keeper, p types.SlashOracleProposal) error {
+	slashed, err := k.Slash(ctx, p.OracleDid, p.Fraction)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashOracle,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, p.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyFraction, p.Fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+		),
+	)
+	return nil
+}
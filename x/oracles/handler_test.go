@@ -0,0 +1,67 @@
+package oracles
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string, attributes map[string]string) {
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	event := events[len(events)-1]
+	require.Equal(t, eventType, event.Type)
+	for _, attribute := range event.Attributes {
+		if value, ok := attributes[string(attribute.Key)]; ok {
+			require.Equal(t, value, string(attribute.Value))
+			delete(attributes, string(attribute.Key))
+		}
+	}
+	require.Empty(t, attributes, "missing event attributes")
+}
+
+func TestOracleProposalHandler(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewOracleProposalHandler(k)
+	oracleDid := exported.NewDidGeneratorBuilder().Build()
+	caps, err := types.ParseOracleTokenCaps("mdap:mint/burn")
+	require.Nil(t, err)
+
+	// add
+	oracle := types.NewOracle(oracleDid.Did, caps)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, handler(ctx, types.NewAddOracleProposal("title", "description", oracle)))
+	requireEvent(t, ctx, types.EventTypeAddOracle, map[string]string{
+		types.AttributeKeyOracleDid:    oracleDid.Did,
+		types.AttributeKeyCapabilities: caps.String(),
+		types.AttributeKeyProofScheme:  "",
+	})
+	require.NotNil(t, handler(ctx, types.NewAddOracleProposal("title", "description", oracle)))
+
+	// update, which sets the proof scheme
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	update := types.NewUpdateOracleProposal("title", "description", oracleDid.Did, caps[:1],
+		types.ProofSchemeEd25519, oracleDid.VerifyKey)
+	require.Nil(t, handler(ctx, update))
+	requireEvent(t, ctx, types.EventTypeUpdateOracle, map[string]string{
+		types.AttributeKeyOracleDid:    oracleDid.Did,
+		types.AttributeKeyCapabilities: caps[:1].String(),
+		types.AttributeKeyProofScheme:  string(types.ProofSchemeEd25519),
+	})
+	require.Equal(t, update.GetOracle(), k.MustGetOracle(ctx, oracleDid.Did))
+
+	// remove
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, handler(ctx, types.NewRemoveOracleProposal("title", "description", oracleDid.Did)))
+	requireEvent(t, ctx, types.EventTypeRemoveOracle, map[string]string{
+		types.AttributeKeyOracleDid: oracleDid.Did,
+	})
+
+	// an oracle that is not registered cannot be updated or removed
+	require.NotNil(t, handler(ctx, update))
+	require.NotNil(t, handler(ctx, types.NewRemoveOracleProposal("title", "description", oracleDid.Did)))
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+// GetOracleBond returns the stake that an oracle has bonded, which is zero if
+// it has not bonded any
+func (k Keeper) GetOracleBond(ctx sdk.Context, oracleDid exported.Did) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOracleBondKey(oracleDid))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var bond types.OracleBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond.Amount
+}
+
+// SetOracleBond sets the stake that an oracle has bonded, removing the bond
+// if the stake is zero
+func (k Keeper) SetOracleBond(ctx sdk.Context, oracleDid exported.Did, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.GetOracleBondKey(oracleDid))
+		return
+	}
+	bond := types.NewOracleBond(oracleDid, amount)
+	store.Set(types.GetOracleBondKey(oracleDid), k.cdc.MustMarshalBinaryBare(bond))
+}
+
+// GetOracleBonds returns the bonds of every oracle that has bonded stake
+func (k Keeper) GetOracleBonds(ctx sdk.Context) (bonds []types.OracleBond) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleBondKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.OracleBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		bonds = append(bonds, bond)
+	}
+
+	return bonds
+}
+
+// CheckBonded returns an error if an oracle has bonded less than the min bond
+func (k Keeper) CheckBonded(ctx sdk.Context, oracleDid exported.Did) error {
+	params := k.GetParams(ctx)
+	if bonded := k.GetOracleBond(ctx, oracleDid); bonded.LT(params.MinBond) {
+		return types.ErrInsufficientBond(oracleDid,
+			sdk.NewCoin(params.BondDenom, bonded), sdk.NewCoin(params.BondDenom, params.MinBond))
+	}
+	return nil
+}
+
+// GetNextUnbondingId returns the id of the next unbonding
+func (k Keeper) GetNextUnbondingId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextUnbondingIdKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextUnbondingId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextUnbondingIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetUnbonding stores an unbonding and queues it under its completion time
+func (k Keeper) SetUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetUnbondingKey(unbonding.OracleDid, unbonding.Id)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(unbonding))
+	store.Set(types.GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id), key)
+}
+
+func (k Keeper) deleteUnbonding(ctx sdk.Context, unbonding types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingKey(unbonding.OracleDid, unbonding.Id))
+	store.Delete(types.GetUnbondingQueueKey(unbonding.CompletionTime, unbonding.Id))
+}
+
+// GetUnbondings returns the unbondings of an oracle, or of every oracle if the
+// oracle DID is empty
+func (k Keeper) GetUnbondings(ctx sdk.Context, oracleDid exported.Did) (unbondings []types.Unbonding) {
+	prefix := types.UnbondingKeyPrefix
+	if oracleDid != "" {
+		prefix = types.GetUnbondingKeyPrefix(oracleDid)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.Unbonding
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+// Bond moves stake from the account of an oracle DID to the oracles module
+// account and adds it to the bond of the oracle. The DID does not have to be
+// a registered oracle yet, since it needs the min bond to be registered.
+func (k Keeper) Bond(ctx sdk.Context, oracleDid exported.Did, amount sdk.Coin) error {
+	if err := k.checkBondDenom(ctx, amount); err != nil {
+		return err
+	}
+
+	address, err := k.oracleAddress(ctx, oracleDid)
+	if err != nil {
+		return err
+	}
+
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	k.SetOracleBond(ctx, oracleDid, k.GetOracleBond(ctx, oracleDid).Add(amount.Amount))
+	return nil
+}
+
+// Unbond removes stake from the bond of an oracle and starts its unbonding.
+// The stake can still be slashed until it is paid out at the end of the
+// unbonding period.
+func (k Keeper) Unbond(ctx sdk.Context, oracleDid exported.Did, amount sdk.Coin) (types.Unbonding, error) {
+	if err := k.checkBondDenom(ctx, amount); err != nil {
+		return types.Unbonding{}, err
+	}
+
+	bonded := k.GetOracleBond(ctx, oracleDid)
+	if amount.Amount.GT(bonded) {
+		return types.Unbonding{}, types.ErrInvalidBond(fmt.Sprintf(
+			"cannot unbond %s, oracle has %s%s bonded", amount, bonded, amount.Denom))
+	}
+	k.SetOracleBond(ctx, oracleDid, bonded.Sub(amount.Amount))
+
+	id := k.GetNextUnbondingId(ctx)
+	completionTime := ctx.BlockTime().Add(k.GetParams(ctx).UnbondingPeriod)
+	unbonding := types.NewUnbonding(id, oracleDid, amount.Amount, completionTime)
+	k.SetUnbonding(ctx, unbonding)
+	k.SetNextUnbondingId(ctx, id+1)
+	return unbonding, nil
+}
+
+// CompleteUnbondings pays out the unbondings that completed by the time of
+// the block to their oracles. An unbonding that cannot be paid out is kept and
+// retried in the next block.
+func (k Keeper) CompleteUnbondings(ctx sdk.Context) (completed []types.Unbonding) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnbondingQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.GetUnbondingQueuePrefix(ctx.BlockTime())))
+
+	var due []types.Unbonding
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.Unbonding
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iterator.Value()), &unbonding)
+		due = append(due, unbonding)
+	}
+	iterator.Close()
+
+	bondDenom := k.GetParams(ctx).BondDenom
+	for _, unbonding := range due {
+		address, err := k.oracleAddress(ctx, unbonding.OracleDid)
+		if err == nil {
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+				address, sdk.NewCoins(sdk.NewCoin(bondDenom, unbonding.Amount)))
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to complete %s: %s", unbonding, err))
+			continue
+		}
+
+		k.deleteUnbonding(ctx, unbonding)
+		completed = append(completed, unbonding)
+	}
+
+	return completed
+}
+
+// Slash burns a fraction of the bonded and of the unbonding stake of an
+// oracle and returns the amount burnt. Amounts are rounded down.
+func (k Keeper) Slash(ctx sdk.Context, oracleDid exported.Did, fraction sdk.Dec) (sdk.Coin, error) {
+	slashed := sdk.ZeroInt()
+
+	bonded := k.GetOracleBond(ctx, oracleDid)
+	if slash := bonded.ToDec().Mul(fraction).TruncateInt(); slash.IsPositive() {
+		k.SetOracleBond(ctx, oracleDid, bonded.Sub(slash))
+		slashed = slashed.Add(slash)
+	}
+
+	for _, unbonding := range k.GetUnbondings(ctx, oracleDid) {
+		slash := unbonding.Amount.ToDec().Mul(fraction).TruncateInt()
+		if !slash.IsPositive() {
+			continue
+		}
+
+		unbonding.Amount = unbonding.Amount.Sub(slash)
+		if unbonding.Amount.IsZero() {
+			k.deleteUnbonding(ctx, unbonding)
+		} else {
+			k.SetUnbonding(ctx, unbonding)
+		}
+		slashed = slashed.Add(slash)
+	}
+
+	amount := sdk.NewCoin(k.GetParams(ctx).BondDenom, slashed)
+	if slashed.IsZero() {
+		return amount, types.ErrInvalidBond(fmt.Sprintf("oracle %s has no stake to slash", oracleDid))
+	} else if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return amount, err
+	}
+	return amount, nil
+}
+
+func (k Keeper) checkBondDenom(ctx sdk.Context, amount sdk.Coin) error {
+	if bondDenom := k.GetParams(ctx).BondDenom; amount.Denom != bondDenom {
+		return types.ErrInvalidBond(fmt.Sprintf(
+			"stake must be bonded in %s, not %s", bondDenom, amount.Denom))
+	}
+	return nil
+}
+
+func (k Keeper) oracleAddress(ctx sdk.Context, oracleDid exported.Did) (sdk.AccAddress, error) {
+	didDoc, err := k.didKeeper.GetDidDoc(ctx, oracleDid)
+	if err != nil {
+		return nil, err
+	}
+	return didDoc.Address(), nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func TestBondAndUnbond(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	period := k.GetParams(ctx).UnbondingPeriod
+
+	// stake is bonded in the bond denom only
+	require.NotNil(t, k.Bond(ctx, oracle.Did, sdk.NewInt64Coin("dap", 10)))
+	require.Nil(t, k.Bond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 60)))
+	require.Equal(t, sdk.NewInt(60), k.GetOracleBond(ctx, oracle.Did))
+	require.Equal(t, sdk.NewInt(40), bk.GetCoins(ctx, oracle.Address()).AmountOf("mdap"))
+	require.Equal(t, []types.OracleBond{types.NewOracleBond(oracle.Did, sdk.NewInt(60))}, k.GetOracleBonds(ctx))
+
+	// an oracle cannot unbond in another denom or more than it bonded
+	_, err := k.Unbond(ctx, oracle.Did, sdk.NewInt64Coin("dap", 10))
+	require.NotNil(t, err)
+	_, err = k.Unbond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 61))
+	require.NotNil(t, err)
+	_, err = k.Unbond(ctx, other.Did, sdk.NewInt64Coin("mdap", 1))
+	require.NotNil(t, err)
+
+	unbonding, err := k.Unbond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 60))
+	require.Nil(t, err)
+	require.Equal(t, now.Add(period), unbonding.CompletionTime)
+	require.True(t, k.GetOracleBond(ctx, oracle.Did).IsZero())
+	require.Empty(t, k.GetOracleBonds(ctx))
+	require.Equal(t, []types.Unbonding{unbonding}, k.GetUnbondings(ctx, oracle.Did))
+
+	// the stake is paid out once the unbonding period has passed
+	require.Empty(t, k.CompleteUnbondings(ctx.WithBlockTime(now.Add(period-time.Second))))
+	require.Equal(t, sdk.NewInt(40), bk.GetCoins(ctx, oracle.Address()).AmountOf("mdap"))
+	require.Equal(t, []types.Unbonding{unbonding}, k.CompleteUnbondings(ctx.WithBlockTime(now.Add(period))))
+	require.Equal(t, initialCoins, bk.GetCoins(ctx, oracle.Address()))
+	require.Empty(t, k.GetUnbondings(ctx, ""))
+	require.Empty(t, k.CompleteUnbondings(ctx.WithBlockTime(now.Add(period))))
+}
+
+func TestSlash(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	supply := func() sdk.Int {
+		return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf("mdap")
+	}
+	initialSupply := supply()
+
+	// an oracle without stake cannot be slashed
+	_, err := k.Slash(ctx, oracle.Did, sdk.NewDecWithPrec(5, 1))
+	require.NotNil(t, err)
+
+	require.Nil(t, k.Bond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 60)))
+	_, err = k.Unbond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 20))
+	require.Nil(t, err)
+
+	// both the bonded and the unbonding stake are slashed and burnt
+	slashed, err := k.Slash(ctx, oracle.Did, sdk.NewDecWithPrec(5, 1))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin("mdap", 30), slashed)
+	require.Equal(t, sdk.NewInt(20), k.GetOracleBond(ctx, oracle.Did))
+	unbondings := k.GetUnbondings(ctx, oracle.Did)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, sdk.NewInt(10), unbondings[0].Amount)
+	require.Equal(t, initialSupply.SubRaw(30), supply())
+
+	// slashing everything removes the bond and the unbonding
+	slashed, err = k.Slash(ctx, oracle.Did, sdk.OneDec())
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin("mdap", 30), slashed)
+	require.Empty(t, k.GetOracleBonds(ctx))
+	require.Empty(t, k.GetUnbondings(ctx, ""))
+	require.Equal(t, initialSupply.SubRaw(60), supply())
+}
@@ -4,22 +4,48 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tokenchain/dp-hub/x/did"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
 )
 
 type Keeper struct {
-	cdc      *codec.Codec
-	storeKey sdk.StoreKey
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	supplyKeeper supply.Keeper
+	didKeeper    did.Keeper
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper supply.Keeper, didKeeper did.Keeper) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
+		cdc:          cdc,
+		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper: supplyKeeper,
+		didKeeper:    didKeeper,
 	}
 }
 
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of oracles parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of oracles parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 // GetOracles returns the list of registered oracles
 func (k Keeper) GetOracles(ctx sdk.Context) (oracles types.Oracles) {
 	store := ctx.KVStore(k.storeKey)
@@ -62,3 +88,48 @@ func (k Keeper) SetOracle(ctx sdk.Context, oracle types.Oracle) {
 	key := types.GetOraclePrefixKey(oracle.OracleDid)
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(oracle))
 }
+
+// GetOracle returns a registered oracle
+func (k Keeper) GetOracle(ctx sdk.Context, oracleDid exported.Did) (types.Oracle, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOraclePrefixKey(oracleDid))
+	if bz == nil {
+		return types.Oracle{}, types.ErrOracleNotFound(oracleDid)
+	}
+
+	var oracle types.Oracle
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &oracle)
+	return oracle, nil
+}
+
+// AddOracle registers a new oracle, which must have bonded the min bond
+func (k Keeper) AddOracle(ctx sdk.Context, oracle types.Oracle) error {
+	if k.OracleExists(ctx, oracle.OracleDid) {
+		return types.ErrOracleAlreadyExists(oracle.OracleDid)
+	} else if err := k.CheckBonded(ctx, oracle.OracleDid); err != nil {
+		return err
+	}
+
+	k.SetOracle(ctx, oracle)
+	return nil
+}
+
+// UpdateOracle replaces the capabilities and the proof scheme of a registered
+// oracle
+func (k Keeper) UpdateOracle(ctx sdk.Context, oracle types.Oracle) error {
+	if !k.OracleExists(ctx, oracle.OracleDid) {
+		return types.ErrOracleNotFound(oracle.OracleDid)
+	}
+
+	k.SetOracle(ctx, oracle)
+	return nil
+}
+
+// RemoveOracle unregisters an oracle
+func (k Keeper) RemoveOracle(ctx sdk.Context, oracleDid exported.Did) error {
+	if !k.OracleExists(ctx, oracleDid) {
+		return types.ErrOracleNotFound(oracleDid)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetOraclePrefixKey(oracleDid))
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func testOracle(t *testing.T, oracleDid string, capsStr string) types.Oracle {
+	caps, err := types.ParseOracleTokenCaps(capsStr)
+	require.Nil(t, err)
+	return types.NewOracle(oracleDid, caps)
+}
+
+func TestAddUpdateRemoveOracle(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	added := testOracle(t, oracle.Did, "mdap:mint/burn")
+
+	// an oracle can be added once
+	require.Nil(t, k.AddOracle(ctx, added))
+	require.NotNil(t, k.AddOracle(ctx, added))
+	require.Equal(t, types.Oracles{added}, k.GetOracles(ctx))
+
+	// the update replaces the capabilities and the proof scheme
+	updated := types.NewOracleWithProofScheme(oracle.Did, testOracle(t, oracle.Did, "mdap:mint").Capabilities,
+		types.ProofSchemeEd25519, other.VerifyKey)
+	require.Nil(t, k.UpdateOracle(ctx, updated))
+	stored, err := k.GetOracle(ctx, oracle.Did)
+	require.Nil(t, err)
+	require.Equal(t, updated, stored)
+
+	// an oracle that is not registered cannot be updated or removed
+	require.NotNil(t, k.UpdateOracle(ctx, testOracle(t, other.Did, "mdap:mint")))
+	require.NotNil(t, k.RemoveOracle(ctx, other.Did))
+	require.False(t, k.OracleExists(ctx, other.Did))
+
+	require.Nil(t, k.RemoveOracle(ctx, oracle.Did))
+	require.False(t, k.OracleExists(ctx, oracle.Did))
+	require.NotNil(t, k.RemoveOracle(ctx, oracle.Did))
+}
+
+func TestAddOracleNeedsBond(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	params := k.GetParams(ctx)
+	params.MinBond = sdk.NewInt(50)
+	k.SetParams(ctx, params)
+	added := testOracle(t, oracle.Did, "mdap:mint/burn")
+
+	require.NotNil(t, k.AddOracle(ctx, added))
+	require.Nil(t, k.Bond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 40)))
+	require.NotNil(t, k.AddOracle(ctx, added))
+	require.False(t, k.OracleExists(ctx, oracle.Did))
+
+	require.Nil(t, k.Bond(ctx, oracle.Did, sdk.NewInt64Coin("mdap", 10)))
+	require.Nil(t, k.AddOracle(ctx, added))
+	require.True(t, k.OracleExists(ctx, oracle.Did))
+}
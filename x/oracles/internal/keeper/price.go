@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+// GetNextVoteId returns the id of the next price vote
+func (k Keeper) GetNextVoteId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVoteIdKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextVoteId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVoteIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) SetPriceVote(ctx sdk.Context, vote types.PriceVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceVoteKey(vote.Base, vote.Quote, vote.Id), k.cdc.MustMarshalBinaryBare(vote))
+}
+
+// GetPriceVotes returns the votes on a currency pair that were not pruned yet,
+// oldest first, or the votes on every pair one after another if the base is
+// empty
+func (k Keeper) GetPriceVotes(ctx sdk.Context, base, quote string) (votes []types.PriceVote) {
+	prefix := types.PriceVoteKeyPrefix
+	if base != "" {
+		prefix = types.GetPriceVoteKeyPrefix(base, quote)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.PriceVote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// GetRetainedPriceVotes returns the votes on every pair in the vote window
+// that ends at the current block, which are the votes that can still count
+func (k Keeper) GetRetainedPriceVotes(ctx sdk.Context) (votes []types.PriceVote) {
+	windowStart := ctx.BlockHeight() - k.GetParams(ctx).VoteWindow
+	for _, vote := range k.GetPriceVotes(ctx, "", "") {
+		if vote.Height > windowStart {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
+// pruneStalePriceVotes deletes the votes on every pair from before the vote
+// window that ends at the current block
+func (k Keeper) pruneStalePriceVotes(ctx sdk.Context) {
+	windowStart := ctx.BlockHeight() - k.GetParams(ctx).VoteWindow
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceVoteKeyPrefix)
+
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.PriceVote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		if vote.Height <= windowStart {
+			stale = append(stale, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// GetFreshPriceVotes returns the latest vote of every oracle on a currency
+// pair in the vote window that ends at the current block
+func (k Keeper) GetFreshPriceVotes(ctx sdk.Context, base, quote string) (votes []types.PriceVote) {
+	windowStart := ctx.BlockHeight() - k.GetParams(ctx).VoteWindow
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetPriceVoteKeyPrefix(base, quote))
+
+	defer iterator.Close()
+	voted := make(map[exported.Did]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.PriceVote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		if vote.Height > ctx.BlockHeight() {
+			// a vote imported at genesis from a chain that got further
+			continue
+		} else if vote.Height <= windowStart {
+			// votes are ordered by height, so every vote from here is stale
+			break
+		} else if voted[vote.OracleDid] {
+			continue
+		}
+		voted[vote.OracleDid] = true
+
+		// an oracle that lost its capability since it voted is not counted
+		if oracle, err := k.GetOracle(ctx, vote.OracleDid); err != nil || !canFeedPrice(oracle, base) {
+			continue
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// SubmitPrice adds an oracle's vote on the price of the base denom in the
+// quote denom, to be aggregated at the end of the vote window
+func (k Keeper) SubmitPrice(ctx sdk.Context, oracleDid exported.Did, base, quote string, price sdk.Dec) (types.PriceVote, error) {
+	oracle, err := k.GetOracle(ctx, oracleDid)
+	if err != nil {
+		return types.PriceVote{}, err
+	} else if !canFeedPrice(oracle, base) {
+		return types.PriceVote{}, exported.Unauthorized(fmt.Sprintf(
+			"oracle does not have capability to feed the price of %s", base))
+	} else if err := k.CheckBonded(ctx, oracleDid); err != nil {
+		return types.PriceVote{}, err
+	}
+
+	id := k.GetNextVoteId(ctx)
+	vote := types.NewPriceVote(id, oracleDid, base, quote, price, ctx.BlockHeight())
+	k.SetPriceVote(ctx, vote)
+	k.SetNextVoteId(ctx, id+1)
+
+	ctx.KVStore(k.storeKey).Set(types.GetPendingPairKey(base, quote),
+		k.cdc.MustMarshalBinaryBare([]string{base, quote}))
+	return vote, nil
+}
+
+// GetExchangeRate returns the latest aggregated exchange rate of a currency
+// pair, for other modules to price the base denom in the quote denom. The
+// height of the rate tells how stale it is.
+func (k Keeper) GetExchangeRate(ctx sdk.Context, base, quote string) (types.ExchangeRate, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetExchangeRateKey(base, quote))
+	if bz == nil {
+		return types.ExchangeRate{}, types.ErrExchangeRateNotFound(base, quote)
+	}
+
+	var rate types.ExchangeRate
+	k.cdc.MustUnmarshalBinaryBare(bz, &rate)
+	return rate, nil
+}
+
+func (k Keeper) SetExchangeRate(ctx sdk.Context, rate types.ExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExchangeRateKey(rate.Base, rate.Quote), k.cdc.MustMarshalBinaryBare(rate))
+}
+
+// GetExchangeRates returns the exchange rates of every currency pair
+func (k Keeper) GetExchangeRates(ctx sdk.Context) (rates []types.ExchangeRate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ExchangeRateKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rate types.ExchangeRate
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rate)
+		rates = append(rates, rate)
+	}
+
+	return rates
+}
+
+// AggregateExchangeRates sets the exchange rate of every currency pair that
+// was voted on in the vote window to the median of its fresh votes, if there
+// are enough of them, and then prunes the votes from before the window. It
+// does nothing unless the current block ends a window.
+func (k Keeper) AggregateExchangeRates(ctx sdk.Context) (rates []types.ExchangeRate) {
+	params := k.GetParams(ctx)
+	if ctx.BlockHeight()%params.VoteWindow != 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingPairKeyPrefix)
+
+	var pairs [][]string
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var pair []string
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pair)
+		pairs = append(pairs, pair)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i, pair := range pairs {
+		store.Delete(keys[i])
+
+		votes := k.GetFreshPriceVotes(ctx, pair[0], pair[1])
+		if len(votes) == 0 || uint64(len(votes)) < params.MinVotes {
+			continue
+		}
+
+		rate := types.NewExchangeRate(pair[0], pair[1],
+			types.MedianPrice(votes), uint64(len(votes)), ctx.BlockHeight())
+		k.SetExchangeRate(ctx, rate)
+		rates = append(rates, rate)
+	}
+
+	k.pruneStalePriceVotes(ctx)
+	return rates
+}
+
+func canFeedPrice(oracle types.Oracle, base string) bool {
+	return oracle.Capabilities.Includes(base) &&
+		oracle.Capabilities.MustGet(base).Capabilities.Includes(types.PriceFeedCap)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+func TestPriceVotesPruned(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	require.Nil(t, k.AddOracle(ctx, testOracle(t, oracle.Did, "mdap:price_feed")))
+	price := sdk.MustNewDecFromStr("1.5")
+
+	_, err := k.SubmitPrice(ctx.WithBlockHeight(5), oracle.Did, "mdap", "usd", price)
+	require.Nil(t, err)
+	require.Len(t, k.AggregateExchangeRates(ctx.WithBlockHeight(10)), 1)
+	require.Len(t, k.GetPriceVotes(ctx, "mdap", "usd"), 1)
+
+	// the votes from before the window are pruned at the end of the window
+	late, err := k.SubmitPrice(ctx.WithBlockHeight(12), oracle.Did, "mdap", "usd", price)
+	require.Nil(t, err)
+	require.Len(t, k.AggregateExchangeRates(ctx.WithBlockHeight(20)), 1)
+	require.Equal(t, []types.PriceVote{late}, k.GetPriceVotes(ctx, "", ""))
+
+	// a pair that is no longer voted on is pruned as well
+	require.Empty(t, k.AggregateExchangeRates(ctx.WithBlockHeight(30)))
+	require.Empty(t, k.GetPriceVotes(ctx, "", ""))
+}
+
+func TestGetRetainedPriceVotes(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	require.Nil(t, k.AddOracle(ctx, testOracle(t, oracle.Did, "mdap:price_feed")))
+	price := sdk.MustNewDecFromStr("1.5")
+
+	_, err := k.SubmitPrice(ctx.WithBlockHeight(5), oracle.Did, "mdap", "usd", price)
+	require.Nil(t, err)
+	fresh, err := k.SubmitPrice(ctx.WithBlockHeight(12), oracle.Did, "mdap", "usd", price)
+	require.Nil(t, err)
+
+	// only the votes in the window that ends at the block are exported
+	require.Len(t, k.GetPriceVotes(ctx, "", ""), 2)
+	require.Equal(t, []types.PriceVote{fresh}, k.GetRetainedPriceVotes(ctx.WithBlockHeight(15)))
+	require.Empty(t, k.GetRetainedPriceVotes(ctx.WithBlockHeight(22)))
+}
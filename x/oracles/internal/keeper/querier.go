@@ -5,10 +5,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
 )
 
 const (
-	QueryOracles = "queryOracles"
+	QueryOracles       = "queryOracles"
+	QueryParams        = "queryParams"
+	QueryExchangeRate  = "queryExchangeRate"
+	QueryExchangeRates = "queryExchangeRates"
+	QueryPriceVotes    = "queryPriceVotes"
+	QueryOracleBond    = "queryOracleBond"
+	QueryUnbondings    = "queryUnbondings"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -16,6 +23,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryOracles:
 			return queryOracles(ctx, k)
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryExchangeRate:
+			return queryExchangeRate(ctx, path[1:], k)
+		case QueryExchangeRates:
+			return queryExchangeRates(ctx, k)
+		case QueryPriceVotes:
+			return queryPriceVotes(ctx, path[1:], k)
+		case QueryOracleBond:
+			return queryOracleBond(ctx, path[1:], k)
+		case QueryUnbondings:
+			return queryUnbondings(ctx, path[1:], k)
 		default:
 			return nil, exported.UnknownRequest("unknown oracles query endpoint")
 		}
@@ -30,3 +49,90 @@ func queryOracles(ctx sdk.Context, k Keeper) ([]byte, error) {
 	}
 	return res, nil
 }
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryExchangeRate(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, exported.UnknownRequest("base or quote is missing")
+	}
+
+	rate, err := k.GetExchangeRate(ctx, path[0], path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, rate)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryExchangeRates(ctx sdk.Context, k Keeper) ([]byte, error) {
+	rates := k.GetExchangeRates(ctx)
+	if rates == nil {
+		rates = []types.ExchangeRate{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, rates)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryPriceVotes(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, exported.UnknownRequest("base or quote is missing")
+	}
+
+	votes := k.GetPriceVotes(ctx, path[0], path[1])
+	if votes == nil {
+		votes = []types.PriceVote{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, votes)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryOracleBond(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.UnknownRequest("oracle did is missing")
+	}
+
+	bond := types.NewOracleBond(path[0], k.GetOracleBond(ctx, path[0]))
+	res, err := codec.MarshalJSONIndent(k.cdc, bond)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
+
+func queryUnbondings(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	var oracleDid exported.Did
+	if len(path) > 0 {
+		oracleDid = path[0]
+	}
+
+	unbondings := k.GetUnbondings(ctx, oracleDid)
+	if unbondings == nil {
+		unbondings = []types.Unbonding{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, unbondings)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles/internal/types"
+)
+
+var (
+	oracle = exported.NewDidGeneratorBuilder().Build()
+	other  = exported.NewDidGeneratorBuilder().Build()
+
+	initialCoins, _ = sdk.ParseCoins("100mdap")
+)
+
+// CreateTestInput returns an oracles keeper with the default params, under
+// which no bond is needed. The oracle and other DIDs have did docs and funded
+// accounts, but are not registered oracles.
+func CreateTestInput() (sdk.Context, Keeper, bank.Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	didKey := sdk.NewKVStoreKey(did.StoreKey)
+	keyParams := sdk.NewKVStoreKey("subspace")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(didKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+
+	_ = ms.LoadLatestVersion()
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	module.NewBasicManager(auth.AppModuleBasic{}, supply.AppModuleBasic{}).RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	exported.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	pk1 := params.NewKeeper(cdc, keyParams, tkeyParams)
+	maccPerms := map[string][]string{
+		types.ModuleName: {supply.Burner},
+	}
+
+	accountKeeper := auth.NewAccountKeeper(cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accountKeeper, bankKeeper, maccPerms)
+	didKeeper := did.NewKeeper(cdc, didKey, pk1.Subspace(did.DefaultParamspace))
+
+	keeper := NewKeeper(cdc, storeKey, pk1.Subspace(types.DefaultParamspace), supplyKeeper, didKeeper)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	didHandler := did.NewHandler(didKeeper)
+	for _, id := range []exported.IxoDid{oracle, other} {
+		_, err := didHandler(ctx, did.NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType))
+		if err != nil {
+			panic(err)
+		}
+		if err := bankKeeper.SetCoins(ctx, id.Address(), initialCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	// the supply covers the funded accounts, so that burns can be checked
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(initialCoins.Add(initialCoins...)))
+
+	return ctx, keeper, bankKeeper
+}
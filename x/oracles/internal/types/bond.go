@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// OracleBond is the stake that an oracle has bonded in the bond denom. The
+// bonded stake is held by the oracles module account.
+type OracleBond struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Amount    sdk.Int      `json:"amount" yaml:"amount"`
+}
+
+func NewOracleBond(oracleDid exported.Did, amount sdk.Int) OracleBond {
+	return OracleBond{
+		OracleDid: oracleDid,
+		Amount:    amount,
+	}
+}
+
+// Unbonding is stake that an oracle unbonded, which is paid out to the oracle
+// at the completion time and can be slashed until then
+type Unbonding struct {
+	Id             uint64       `json:"id" yaml:"id"`
+	OracleDid      exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Amount         sdk.Int      `json:"amount" yaml:"amount"`
+	CompletionTime time.Time    `json:"completion_time" yaml:"completion_time"`
+}
+
+func NewUnbonding(id uint64, oracleDid exported.Did, amount sdk.Int, completionTime time.Time) Unbonding {
+	return Unbonding{
+		Id:             id,
+		OracleDid:      oracleDid,
+		Amount:         amount,
+		CompletionTime: completionTime,
+	}
+}
+
+func (u Unbonding) String() string {
+	return fmt.Sprintf("unbonding %d of %s: %s at %s", u.Id, u.OracleDid, u.Amount, u.CompletionTime)
+}
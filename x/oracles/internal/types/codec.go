@@ -10,6 +10,9 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(Oracle{}, "oracles/Oracle", nil)
 	cdc.RegisterConcrete(OracleTokenCap{}, "oracles/OracleTokenCap", nil)
+	cdc.RegisterConcrete(MsgSubmitPrice{}, "oracles/MsgSubmitPrice", nil)
+	cdc.RegisterConcrete(MsgBondOracle{}, "oracles/MsgBondOracle", nil)
+	cdc.RegisterConcrete(MsgUnbondOracle{}, "oracles/MsgUnbondOracle", nil)
 }
 
 func init() {
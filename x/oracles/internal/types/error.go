@@ -1,2 +1,37 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func ErrInvalidOracle(reason string) error {
+	return errors.Wrap(exported.EInvalidOracle, reason)
+}
+
+func ErrOracleNotFound(oracleDid exported.Did) error {
+	return errors.Wrap(exported.EOracleNotFound, fmt.Sprintf("oracle %s", oracleDid))
+}
+
+func ErrOracleAlreadyExists(oracleDid exported.Did) error {
+	return errors.Wrap(exported.EOracleAlreadyExists, fmt.Sprintf("oracle %s", oracleDid))
+}
+
+func ErrInvalidPriceVote(reason string) error {
+	return errors.Wrap(exported.EInvalidPriceVote, reason)
+}
+
+func ErrExchangeRateNotFound(base, quote string) error {
+	return errors.Wrap(exported.EExchangeRateNotFound, fmt.Sprintf("%s/%s", base, quote))
+}
+
+func ErrInvalidBond(reason string) error {
+	return errors.Wrap(exported.EInvalidBond, reason)
+}
+
+func ErrInsufficientBond(oracleDid exported.Did, bonded, minBond fmt.Stringer) error {
+	return errors.Wrap(exported.EInsufficientBond, fmt.Sprintf(
+		"oracle %s has %s bonded, less than the min of %s", oracleDid, bonded, minBond))
+}
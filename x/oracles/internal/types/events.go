@@ -0,0 +1,27 @@
+package types
+
+const (
+	EventTypeAddOracle    = "add_oracle"
+	EventTypeUpdateOracle = "update_oracle"
+	EventTypeRemoveOracle = "remove_oracle"
+	EventTypeSubmitPrice  = "submit_price"
+	EventTypeExchangeRate = "exchange_rate"
+	EventTypeBondOracle   = "bond_oracle"
+	EventTypeUnbondOracle = "unbond_oracle"
+	EventTypeUnbonded     = "complete_unbonding"
+	EventTypeSlashOracle  = "slash_oracle"
+
+	AttributeKeyOracleDid    = "oracle_did"
+	AttributeKeyCapabilities = "capabilities"
+	AttributeKeyProofScheme  = "proof_scheme"
+	AttributeKeyBase         = "base"
+	AttributeKeyQuote        = "quote"
+	AttributeKeyPrice        = "price"
+	AttributeKeyRate         = "rate"
+	AttributeKeyVotes        = "votes"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyFraction     = "fraction"
+	AttributeKeyCompletion   = "completion_time"
+
+	AttributeValueCategory = ModuleName
+)
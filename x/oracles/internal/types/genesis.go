@@ -1,21 +1,105 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
 type GenesisState struct {
-	Oracles Oracles `json:"oracles" yaml:"oracles"`
+	Oracles       Oracles        `json:"oracles" yaml:"oracles"`
+	Params        Params         `json:"params" yaml:"params"`
+	ExchangeRates []ExchangeRate `json:"exchange_rates" yaml:"exchange_rates"`
+	PriceVotes    []PriceVote    `json:"price_votes" yaml:"price_votes"`
+	NextVoteId    uint64         `json:"next_vote_id" yaml:"next_vote_id"`
+
+	// The bonded and unbonding stake must add up to the balance of the oracles
+	// module account
+	Bonds           []OracleBond `json:"bonds" yaml:"bonds"`
+	Unbondings      []Unbonding  `json:"unbondings" yaml:"unbondings"`
+	NextUnbondingId uint64       `json:"next_unbonding_id" yaml:"next_unbonding_id"`
 }
 
-func NewGenesisState(oracles Oracles) GenesisState {
+func NewGenesisState(oracles Oracles, params Params, exchangeRates []ExchangeRate,
+	priceVotes []PriceVote, nextVoteId uint64, bonds []OracleBond,
+	unbondings []Unbonding, nextUnbondingId uint64) GenesisState {
 	return GenesisState{
-		Oracles: oracles,
+		Oracles:         oracles,
+		Params:          params,
+		ExchangeRates:   exchangeRates,
+		PriceVotes:      priceVotes,
+		NextVoteId:      nextVoteId,
+		Bonds:           bonds,
+		Unbondings:      unbondings,
+		NextUnbondingId: nextUnbondingId,
 	}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	seen := make(map[exported.Did]bool)
+	for _, o := range data.Oracles {
+		if seen[o.OracleDid] {
+			return fmt.Errorf("duplicate oracle %s", o.OracleDid)
+		} else if err := o.Validate(); err != nil {
+			return err
+		}
+		seen[o.OracleDid] = true
+	}
+
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	for _, er := range data.ExchangeRates {
+		if err := ValidatePair(er.Base, er.Quote); err != nil {
+			return err
+		} else if er.Rate.IsNil() || !er.Rate.IsPositive() {
+			return fmt.Errorf("exchange rate of %s/%s must be positive", er.Base, er.Quote)
+		}
+	}
+
+	for _, vote := range data.PriceVotes {
+		if vote.Id >= data.NextVoteId {
+			return fmt.Errorf("price vote id %d is not below the next vote id %d", vote.Id, data.NextVoteId)
+		} else if err := ValidatePair(vote.Base, vote.Quote); err != nil {
+			return err
+		}
+	}
+
+	bonded := make(map[exported.Did]bool)
+	for _, bond := range data.Bonds {
+		if !exported.IsValidDid(bond.OracleDid) {
+			return exported.ErrInvalidDid("oracle did is invalid: " + bond.OracleDid)
+		} else if bonded[bond.OracleDid] {
+			return fmt.Errorf("duplicate bond of oracle %s", bond.OracleDid)
+		} else if bond.Amount == (sdk.Int{}) || !bond.Amount.IsPositive() {
+			return fmt.Errorf("bond of oracle %s must be positive", bond.OracleDid)
+		}
+		bonded[bond.OracleDid] = true
+	}
+
+	for _, u := range data.Unbondings {
+		if u.Id >= data.NextUnbondingId {
+			return fmt.Errorf("unbonding id %d is not below the next unbonding id %d", u.Id, data.NextUnbondingId)
+		} else if !exported.IsValidDid(u.OracleDid) {
+			return exported.ErrInvalidDid("oracle did is invalid: " + u.OracleDid)
+		} else if u.Amount == (sdk.Int{}) || !u.Amount.IsPositive() {
+			return fmt.Errorf("unbonding %d must be positive", u.Id)
+		}
+	}
 	return nil
 }
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Oracles: nil,
+		Oracles:         nil,
+		Params:          DefaultParams(),
+		ExchangeRates:   nil,
+		PriceVotes:      nil,
+		NextVoteId:      0,
+		Bonds:           nil,
+		Unbondings:      nil,
+		NextUnbondingId: 0,
 	}
 }
@@ -1,20 +1,79 @@
 package types
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 )
 
 const (
-	ModuleName   = "oracles"
-	StoreKey     = ModuleName
-	RouterKey    = ModuleName
-	QuerierRoute = ModuleName
+	ModuleName        = "oracles"
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
+	DefaultParamspace = ModuleName
 )
 
 var (
-	OracleKey = []byte{0x00}
+	OracleKey             = []byte{0x00}
+	PriceVoteKeyPrefix    = []byte{0x01}
+	ExchangeRateKeyPrefix = []byte{0x02}
+	PendingPairKeyPrefix  = []byte{0x03}
+	NextVoteIdKey         = []byte{0x04}
+
+	OracleBondKeyPrefix     = []byte{0x05}
+	UnbondingKeyPrefix      = []byte{0x06}
+	UnbondingQueueKeyPrefix = []byte{0x07}
+	NextUnbondingIdKey      = []byte{0x08}
 )
 
 func GetOraclePrefixKey(did exported.Did) []byte {
 	return append(OracleKey, []byte(did)...)
 }
+
+// getPairBytes returns the key bytes of a currency pair. Both denoms are
+// terminated so that a pair is never a prefix of another pair.
+func getPairBytes(base, quote string) []byte {
+	bz := append([]byte(base), 0x00)
+	bz = append(bz, []byte(quote)...)
+	return append(bz, 0x00)
+}
+
+func GetPriceVoteKeyPrefix(base, quote string) []byte {
+	return append(PriceVoteKeyPrefix, getPairBytes(base, quote)...)
+}
+
+func GetPriceVoteKey(base, quote string, id uint64) []byte {
+	return append(GetPriceVoteKeyPrefix(base, quote), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetExchangeRateKey(base, quote string) []byte {
+	return append(ExchangeRateKeyPrefix, getPairBytes(base, quote)...)
+}
+
+func GetPendingPairKey(base, quote string) []byte {
+	return append(PendingPairKeyPrefix, getPairBytes(base, quote)...)
+}
+
+func GetOracleBondKey(oracleDid exported.Did) []byte {
+	return append(OracleBondKeyPrefix, []byte(oracleDid)...)
+}
+
+func GetUnbondingKeyPrefix(oracleDid exported.Did) []byte {
+	return append(UnbondingKeyPrefix, []byte(oracleDid+"/")...)
+}
+
+func GetUnbondingKey(oracleDid exported.Did, id uint64) []byte {
+	return append(GetUnbondingKeyPrefix(oracleDid), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingQueueKey returns the key of an unbonding in the queue of the
+// unbondings that complete at a time. Keys are ordered by time, then by id.
+func GetUnbondingQueueKey(t time.Time, id uint64) []byte {
+	return append(GetUnbondingQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetUnbondingQueuePrefix(t time.Time) []byte {
+	return append(UnbondingQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const (
+	TypeMsgSubmitPrice  = "submit-price"
+	TypeMsgBondOracle   = "bond-oracle"
+	TypeMsgUnbondOracle = "unbond-oracle"
+)
+
+var (
+	_ ante.IxoMsg = MsgSubmitPrice{}
+	_ ante.IxoMsg = MsgBondOracle{}
+	_ ante.IxoMsg = MsgUnbondOracle{}
+)
+
+// MsgSubmitPrice is an oracle's vote on the price of the base denom in the
+// quote denom
+type MsgSubmitPrice struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Base      string       `json:"base" yaml:"base"`
+	Quote     string       `json:"quote" yaml:"quote"`
+	Price     sdk.Dec      `json:"price" yaml:"price"`
+}
+
+func (msg MsgSubmitPrice) Type() string  { return TypeMsgSubmitPrice }
+func (msg MsgSubmitPrice) Route() string { return RouterKey }
+func (msg MsgSubmitPrice) ValidateBasic() error {
+	// Check that DID valid
+	if !exported.IsValidDid(msg.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid")
+	}
+
+	// Check the pair and that the price is positive
+	if err := ValidatePair(msg.Base, msg.Quote); err != nil {
+		return err
+	} else if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return ErrInvalidPriceVote("price must be positive")
+	}
+
+	return nil
+}
+func (msg MsgSubmitPrice) GetSignerDid() exported.Did { return msg.OracleDid }
+func (msg MsgSubmitPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgSubmitPrice) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgSubmitPrice) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgBondOracle adds to the stake that an oracle has bonded
+type MsgBondOracle struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Amount    sdk.Coin     `json:"amount" yaml:"amount"`
+}
+
+func (msg MsgBondOracle) Type() string  { return TypeMsgBondOracle }
+func (msg MsgBondOracle) Route() string { return RouterKey }
+func (msg MsgBondOracle) ValidateBasic() error {
+	// Check that DID valid
+	if !exported.IsValidDid(msg.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid")
+	}
+
+	// Check that amount valid and positive
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return exported.ErrInvalidCoins("amount must be valid and positive")
+	}
+
+	return nil
+}
+func (msg MsgBondOracle) GetSignerDid() exported.Did { return msg.OracleDid }
+func (msg MsgBondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgBondOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgBondOracle) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgUnbondOracle starts the unbonding of part of the stake that an oracle has
+// bonded, which is paid out at the end of the unbonding period
+type MsgUnbondOracle struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Amount    sdk.Coin     `json:"amount" yaml:"amount"`
+}
+
+func (msg MsgUnbondOracle) Type() string  { return TypeMsgUnbondOracle }
+func (msg MsgUnbondOracle) Route() string { return RouterKey }
+func (msg MsgUnbondOracle) ValidateBasic() error {
+	// Check that DID valid
+	if !exported.IsValidDid(msg.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid")
+	}
+
+	// Check that amount valid and positive
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return exported.ErrInvalidCoins("amount must be valid and positive")
+	}
+
+	return nil
+}
+func (msg MsgUnbondOracle) GetSignerDid() exported.Did { return msg.OracleDid }
+func (msg MsgUnbondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgUnbondOracle) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgUnbondOracle) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	dap "github.com/tokenchain/dp-hub/x/dap/types"
+)
+
+// Parameter store keys
+var (
+	KeyVoteWindow      = []byte("VoteWindow")
+	KeyMinVotes        = []byte("MinVotes")
+	KeyBondDenom       = []byte("BondDenom")
+	KeyMinBond         = []byte("MinBond")
+	KeyUnbondingPeriod = []byte("UnbondingPeriod")
+)
+
+// oracles parameters
+type Params struct {
+	VoteWindow      int64         `json:"vote_window" yaml:"vote_window"`           // blocks per window, after which the votes are aggregated
+	MinVotes        uint64        `json:"min_votes" yaml:"min_votes"`               // fresh votes needed to update an exchange rate
+	BondDenom       string        `json:"bond_denom" yaml:"bond_denom"`             // denom that oracles bond stake in
+	MinBond         sdk.Int       `json:"min_bond" yaml:"min_bond"`                 // stake an oracle needs to be registered and to operate, none if zero
+	UnbondingPeriod time.Duration `json:"unbonding_period" yaml:"unbonding_period"` // time until unbonded stake is paid out
+}
+
+// ParamTable for oracles module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(voteWindow int64, minVotes uint64, bondDenom string,
+	minBond sdk.Int, unbondingPeriod time.Duration) Params {
+	return Params{
+		VoteWindow:      voteWindow,
+		MinVotes:        minVotes,
+		BondDenom:       bondDenom,
+		MinBond:         minBond,
+		UnbondingPeriod: unbondingPeriod,
+	}
+}
+
+// default oracles module parameters
+func DefaultParams() Params {
+	return Params{
+		VoteWindow:      10,
+		MinVotes:        1,
+		BondDenom:       dap.NativeToken,
+		MinBond:         sdk.ZeroInt(),
+		UnbondingPeriod: time.Hour * 24 * 21,
+	}
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyVoteWindow, &p.VoteWindow, validateVoteWindow),
+		params.NewParamSetPair(KeyMinVotes, &p.MinVotes, validateMinVotes),
+		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyMinBond, &p.MinBond, validateMinBond),
+		params.NewParamSetPair(KeyUnbondingPeriod, &p.UnbondingPeriod, validateUnbondingPeriod),
+	}
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	if err := validateVoteWindow(params.VoteWindow); err != nil {
+		return err
+	}
+	if err := validateMinVotes(params.MinVotes); err != nil {
+		return err
+	}
+	if err := validateBondDenom(params.BondDenom); err != nil {
+		return err
+	}
+	if err := validateMinBond(params.MinBond); err != nil {
+		return err
+	}
+	return validateUnbondingPeriod(params.UnbondingPeriod)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Oracles Params:
+  Vote Window:      %d
+  Min Votes:        %d
+  Bond Denom:       %s
+  Min Bond:         %s
+  Unbonding Period: %s
+`,
+		p.VoteWindow, p.MinVotes, p.BondDenom, p.MinBond, p.UnbondingPeriod,
+	)
+}
+
+func validateVoteWindow(i interface{}) error {
+	voteWindow, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if voteWindow <= 0 {
+		return fmt.Errorf("oracles parameter VoteWindow must be positive")
+	}
+	return nil
+}
+
+func validateMinVotes(i interface{}) error {
+	minVotes, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if minVotes == 0 {
+		return fmt.Errorf("oracles parameter MinVotes must be positive")
+	}
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	bondDenom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := sdk.ValidateDenom(bondDenom); err != nil {
+		return fmt.Errorf("oracles parameter BondDenom is invalid: %s", err)
+	}
+	return nil
+}
+
+func validateMinBond(i interface{}) error {
+	minBond, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if minBond == (sdk.Int{}) || minBond.IsNegative() {
+		return fmt.Errorf("oracles parameter MinBond cannot be negative")
+	}
+	return nil
+}
+
+func validateUnbondingPeriod(i interface{}) error {
+	unbondingPeriod, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if unbondingPeriod <= 0 {
+		return fmt.Errorf("oracles parameter UnbondingPeriod must be positive")
+	}
+	return nil
+}
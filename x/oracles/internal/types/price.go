@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// PriceVote is the price of the base denom in the quote denom that an oracle
+// submitted at a height
+type PriceVote struct {
+	Id        uint64       `json:"id" yaml:"id"`
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Base      string       `json:"base" yaml:"base"`
+	Quote     string       `json:"quote" yaml:"quote"`
+	Price     sdk.Dec      `json:"price" yaml:"price"`
+	Height    int64        `json:"height" yaml:"height"`
+}
+
+func NewPriceVote(id uint64, oracleDid exported.Did, base, quote string, price sdk.Dec, height int64) PriceVote {
+	return PriceVote{
+		Id:        id,
+		OracleDid: oracleDid,
+		Base:      base,
+		Quote:     quote,
+		Price:     price,
+		Height:    height,
+	}
+}
+
+// ExchangeRate is the median of the fresh price votes of a currency pair at
+// the end of the vote window in which it was aggregated
+type ExchangeRate struct {
+	Base   string  `json:"base" yaml:"base"`
+	Quote  string  `json:"quote" yaml:"quote"`
+	Rate   sdk.Dec `json:"rate" yaml:"rate"`
+	Votes  uint64  `json:"votes" yaml:"votes"`
+	Height int64   `json:"height" yaml:"height"`
+}
+
+func NewExchangeRate(base, quote string, rate sdk.Dec, votes uint64, height int64) ExchangeRate {
+	return ExchangeRate{
+		Base:   base,
+		Quote:  quote,
+		Rate:   rate,
+		Votes:  votes,
+		Height: height,
+	}
+}
+
+func (er ExchangeRate) String() string {
+	return fmt.Sprintf("%s/%s: %s (%d votes at height %d)",
+		er.Base, er.Quote, er.Rate, er.Votes, er.Height)
+}
+
+// ValidatePair checks that a currency pair consists of two different valid
+// denoms
+func ValidatePair(base, quote string) error {
+	if err := sdk.ValidateDenom(base); err != nil {
+		return ErrInvalidPriceVote(fmt.Sprintf("invalid base: %s", err))
+	} else if err := sdk.ValidateDenom(quote); err != nil {
+		return ErrInvalidPriceVote(fmt.Sprintf("invalid quote: %s", err))
+	} else if base == quote {
+		return ErrInvalidPriceVote("base and quote must be different")
+	}
+	return nil
+}
+
+// MedianPrice returns the median price of the votes, which is the mean of the
+// two middle prices if there is an even number of votes. It panics if there
+// are no votes.
+func MedianPrice(votes []PriceVote) sdk.Dec {
+	prices := make([]sdk.Dec, len(votes))
+	for i, vote := range votes {
+		prices[i] = vote.Price
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+	return prices[mid-1].Add(prices[mid]).QuoInt64(2)
+}
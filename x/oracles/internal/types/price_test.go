@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMedianPrice(t *testing.T) {
+	votes := func(prices ...string) (votes []PriceVote) {
+		for _, p := range prices {
+			votes = append(votes, PriceVote{Price: sdk.MustNewDecFromStr(p)})
+		}
+		return votes
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), MedianPrice(votes("1.5")))
+	require.Equal(t, sdk.MustNewDecFromStr("2"), MedianPrice(votes("3", "1", "2")))
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), MedianPrice(votes("4", "1", "3", "2")))
+	require.Equal(t, sdk.MustNewDecFromStr("1"), MedianPrice(votes("1", "100", "1")))
+}
+
+func TestValidatePair(t *testing.T) {
+	require.Nil(t, ValidatePair("dap", "usd"))
+	require.NotNil(t, ValidatePair("dap", "dap"))
+	require.NotNil(t, ValidatePair("", "usd"))
+	require.NotNil(t, ValidatePair("dap", "U"))
+}
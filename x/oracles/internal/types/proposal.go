@@ -0,0 +1,221 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const (
+	// ProposalTypeAddOracle defines the type for an AddOracleProposal
+	ProposalTypeAddOracle = "AddOracle"
+	// ProposalTypeUpdateOracle defines the type for an UpdateOracleProposal
+	ProposalTypeUpdateOracle = "UpdateOracle"
+	// ProposalTypeRemoveOracle defines the type for a RemoveOracleProposal
+	ProposalTypeRemoveOracle = "RemoveOracle"
+	// ProposalTypeSlashOracle defines the type for a SlashOracleProposal
+	ProposalTypeSlashOracle = "SlashOracle"
+)
+
+var (
+	_ govtypes.Content = AddOracleProposal{}
+	_ govtypes.Content = UpdateOracleProposal{}
+	_ govtypes.Content = RemoveOracleProposal{}
+	_ govtypes.Content = SlashOracleProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddOracle)
+	govtypes.RegisterProposalType(ProposalTypeUpdateOracle)
+	govtypes.RegisterProposalType(ProposalTypeRemoveOracle)
+	govtypes.RegisterProposalType(ProposalTypeSlashOracle)
+	govtypes.RegisterProposalTypeCodec(AddOracleProposal{}, "oracles/AddOracleProposal")
+	govtypes.RegisterProposalTypeCodec(UpdateOracleProposal{}, "oracles/UpdateOracleProposal")
+	govtypes.RegisterProposalTypeCodec(RemoveOracleProposal{}, "oracles/RemoveOracleProposal")
+	govtypes.RegisterProposalTypeCodec(SlashOracleProposal{}, "oracles/SlashOracleProposal")
+}
+
+// --------------------------------------- AddOracleProposal
+
+// AddOracleProposal registers a new oracle
+type AddOracleProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Oracle      Oracle `json:"oracle" yaml:"oracle"`
+}
+
+func NewAddOracleProposal(title, description string, oracle Oracle) AddOracleProposal {
+	return AddOracleProposal{
+		Title:       title,
+		Description: description,
+		Oracle:      oracle,
+	}
+}
+
+func (aop AddOracleProposal) GetTitle() string       { return aop.Title }
+func (aop AddOracleProposal) GetDescription() string { return aop.Description }
+func (aop AddOracleProposal) ProposalRoute() string  { return RouterKey }
+func (aop AddOracleProposal) ProposalType() string   { return ProposalTypeAddOracle }
+
+func (aop AddOracleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(aop); err != nil {
+		return err
+	} else if err := aop.Oracle.Validate(); err != nil {
+		return ErrInvalidOracle(err.Error())
+	}
+	return nil
+}
+
+func (aop AddOracleProposal) String() string {
+	return fmt.Sprintf(`Add Oracle Proposal:
+  Title:         %s
+  Description:   %s
+  Oracle Did:    %s
+  Capabilities:  %s
+  Proof Scheme:  %s
+`, aop.Title, aop.Description, aop.Oracle.OracleDid, aop.Oracle.Capabilities, aop.Oracle.ProofScheme)
+}
+
+// --------------------------------------- UpdateOracleProposal
+
+// UpdateOracleProposal replaces the capabilities, including the mint and burn
+// limits, and the proof scheme and key of a registered oracle. An oracle that
+// is updated without a proof scheme no longer has its proofs checked.
+type UpdateOracleProposal struct {
+	Title        string          `json:"title" yaml:"title"`
+	Description  string          `json:"description" yaml:"description"`
+	OracleDid    exported.Did    `json:"oracle_did" yaml:"oracle_did"`
+	Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+	ProofScheme  ProofScheme     `json:"proof_scheme,omitempty" yaml:"proof_scheme,omitempty"`
+	ProofKey     string          `json:"proof_key,omitempty" yaml:"proof_key,omitempty"`
+}
+
+func NewUpdateOracleProposal(title, description string, oracleDid exported.Did,
+	capabilities OracleTokenCaps, proofScheme ProofScheme, proofKey string) UpdateOracleProposal {
+	return UpdateOracleProposal{
+		Title:        title,
+		Description:  description,
+		OracleDid:    oracleDid,
+		Capabilities: capabilities,
+		ProofScheme:  proofScheme,
+		ProofKey:     proofKey,
+	}
+}
+
+func (uop UpdateOracleProposal) GetTitle() string       { return uop.Title }
+func (uop UpdateOracleProposal) GetDescription() string { return uop.Description }
+func (uop UpdateOracleProposal) ProposalRoute() string  { return RouterKey }
+func (uop UpdateOracleProposal) ProposalType() string   { return ProposalTypeUpdateOracle }
+
+// GetOracle returns the oracle as it is after the update
+func (uop UpdateOracleProposal) GetOracle() Oracle {
+	return NewOracleWithProofScheme(uop.OracleDid, uop.Capabilities, uop.ProofScheme, uop.ProofKey)
+}
+
+func (uop UpdateOracleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(uop); err != nil {
+		return err
+	} else if err := uop.GetOracle().Validate(); err != nil {
+		return ErrInvalidOracle(err.Error())
+	}
+	return nil
+}
+
+func (uop UpdateOracleProposal) String() string {
+	return fmt.Sprintf(`Update Oracle Proposal:
+  Title:         %s
+  Description:   %s
+  Oracle Did:    %s
+  Capabilities:  %s
+  Proof Scheme:  %s
+`, uop.Title, uop.Description, uop.OracleDid, uop.Capabilities, uop.ProofScheme)
+}
+
+// --------------------------------------- RemoveOracleProposal
+
+// RemoveOracleProposal removes a registered oracle, revoking all of its
+// capabilities
+type RemoveOracleProposal struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	OracleDid   exported.Did `json:"oracle_did" yaml:"oracle_did"`
+}
+
+func NewRemoveOracleProposal(title, description string, oracleDid exported.Did) RemoveOracleProposal {
+	return RemoveOracleProposal{
+		Title:       title,
+		Description: description,
+		OracleDid:   oracleDid,
+	}
+}
+
+func (rop RemoveOracleProposal) GetTitle() string       { return rop.Title }
+func (rop RemoveOracleProposal) GetDescription() string { return rop.Description }
+func (rop RemoveOracleProposal) ProposalRoute() string  { return RouterKey }
+func (rop RemoveOracleProposal) ProposalType() string   { return ProposalTypeRemoveOracle }
+
+func (rop RemoveOracleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rop); err != nil {
+		return err
+	} else if !exported.IsValidDid(rop.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid: " + rop.OracleDid)
+	}
+	return nil
+}
+
+func (rop RemoveOracleProposal) String() string {
+	return fmt.Sprintf(`Remove Oracle Proposal:
+  Title:         %s
+  Description:   %s
+  Oracle Did:    %s
+`, rop.Title, rop.Description, rop.OracleDid)
+}
+
+// --------------------------------------- SlashOracleProposal
+
+// SlashOracleProposal burns a fraction of the stake of an oracle that
+// misbehaved, such as by minting with an invalid proof or by missing price
+// feed windows. Stake that is still unbonding is slashed by the same fraction.
+type SlashOracleProposal struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	OracleDid   exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Fraction    sdk.Dec      `json:"fraction" yaml:"fraction"`
+}
+
+func NewSlashOracleProposal(title, description string, oracleDid exported.Did,
+	fraction sdk.Dec) SlashOracleProposal {
+	return SlashOracleProposal{
+		Title:       title,
+		Description: description,
+		OracleDid:   oracleDid,
+		Fraction:    fraction,
+	}
+}
+
+func (sop SlashOracleProposal) GetTitle() string       { return sop.Title }
+func (sop SlashOracleProposal) GetDescription() string { return sop.Description }
+func (sop SlashOracleProposal) ProposalRoute() string  { return RouterKey }
+func (sop SlashOracleProposal) ProposalType() string   { return ProposalTypeSlashOracle }
+
+func (sop SlashOracleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sop); err != nil {
+		return err
+	} else if !exported.IsValidDid(sop.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid: " + sop.OracleDid)
+	} else if sop.Fraction.IsNil() || !sop.Fraction.IsPositive() || sop.Fraction.GT(sdk.OneDec()) {
+		return ErrInvalidBond("slash fraction must be positive and at most one")
+	}
+	return nil
+}
+
+func (sop SlashOracleProposal) String() string {
+	return fmt.Sprintf(`Slash Oracle Proposal:
+  Title:         %s
+  Description:   %s
+  Oracle Did:    %s
+  Fraction:      %s
+`, sop.Title, sop.Description, sop.OracleDid, sop.Fraction)
+}
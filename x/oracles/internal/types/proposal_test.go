@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func TestOracleProposalsValidateBasic(t *testing.T) {
+	oracleDid := exported.NewDidGeneratorBuilder().Build()
+	caps, err := ParseOracleTokenCaps("dap:mint/burn")
+	require.Nil(t, err)
+
+	// add oracle
+	oracle := NewOracleWithProofScheme(oracleDid.Did, caps, ProofSchemeEd25519, oracleDid.VerifyKey)
+	require.Nil(t, NewAddOracleProposal("title", "description", oracle).ValidateBasic())
+	require.NotNil(t, NewAddOracleProposal("", "description", oracle).ValidateBasic())
+	require.NotNil(t, NewAddOracleProposal("title", "description", NewOracle("oracle", caps)).ValidateBasic())
+	oracle.ProofKey = ""
+	require.NotNil(t, NewAddOracleProposal("title", "description", oracle).ValidateBasic())
+
+	// update oracle
+	require.Nil(t, NewUpdateOracleProposal("title", "description", oracleDid.Did, caps,
+		ProofSchemeNone, "").ValidateBasic())
+	require.Nil(t, NewUpdateOracleProposal("title", "description", oracleDid.Did, caps,
+		ProofSchemeEd25519, oracleDid.VerifyKey).ValidateBasic())
+	require.NotNil(t, NewUpdateOracleProposal("title", "", oracleDid.Did, caps,
+		ProofSchemeNone, "").ValidateBasic())
+	require.NotNil(t, NewUpdateOracleProposal("title", "description", "oracle", caps,
+		ProofSchemeNone, "").ValidateBasic())
+	require.NotNil(t, NewUpdateOracleProposal("title", "description", oracleDid.Did, caps,
+		ProofSchemeNone, oracleDid.VerifyKey).ValidateBasic())
+	require.NotNil(t, NewUpdateOracleProposal("title", "description", oracleDid.Did, caps,
+		"rsa", oracleDid.VerifyKey).ValidateBasic())
+
+	// remove oracle
+	require.Nil(t, NewRemoveOracleProposal("title", "description", oracleDid.Did).ValidateBasic())
+	require.NotNil(t, NewRemoveOracleProposal("", "description", oracleDid.Did).ValidateBasic())
+	require.NotNil(t, NewRemoveOracleProposal("title", "description", "oracle").ValidateBasic())
+
+	// slash oracle
+	require.Nil(t, NewSlashOracleProposal("title", "description", oracleDid.Did,
+		sdk.OneDec()).ValidateBasic())
+	require.NotNil(t, NewSlashOracleProposal("title", "description", "oracle",
+		sdk.OneDec()).ValidateBasic())
+	require.NotNil(t, NewSlashOracleProposal("title", "description", oracleDid.Did,
+		sdk.ZeroDec()).ValidateBasic())
+	require.NotNil(t, NewSlashOracleProposal("title", "description", oracleDid.Did,
+		sdk.NewDec(2)).ValidateBasic())
+	require.NotNil(t, NewSlashOracleProposal("title", "description", oracleDid.Did,
+		sdk.Dec{}).ValidateBasic())
+}
@@ -2,7 +2,11 @@ package types
 
 import (
 	"fmt"
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tokenchain/dp-hub/x/did/exported"
+	"strconv"
 	"strings"
 )
 
@@ -12,6 +16,8 @@ type (
 	Oracle struct {
 		OracleDid    exported.Did       `json:"oracle_did" yaml:"oracle_did"`
 		Capabilities OracleTokenCaps `json:"capabilities" yaml:"capabilities"`
+		ProofScheme  ProofScheme     `json:"proof_scheme,omitempty" yaml:"proof_scheme,omitempty"`
+		ProofKey     string          `json:"proof_key,omitempty" yaml:"proof_key,omitempty"` // base58 key that proofs are checked against
 	}
 	Oracles []Oracle
 )
@@ -23,6 +29,67 @@ func NewOracle(oracleDid exported.Did, caps OracleTokenCaps) Oracle {
 	}
 }
 
+func NewOracleWithProofScheme(oracleDid exported.Did, caps OracleTokenCaps,
+	proofScheme ProofScheme, proofKey string) Oracle {
+	return Oracle{
+		OracleDid:    oracleDid,
+		Capabilities: caps,
+		ProofScheme:  proofScheme,
+		ProofKey:     proofKey,
+	}
+}
+
+// Validate checks that the oracle has a valid DID, valid capabilities with at
+// most one entry per token, and a valid proof scheme and limits
+func (o Oracle) Validate() error {
+	if !exported.IsValidDid(o.OracleDid) {
+		return fmt.Errorf("oracle did is invalid: %s", o.OracleDid)
+	} else if err := o.Capabilities.Validate(); err != nil {
+		return fmt.Errorf("oracle %s has invalid capabilities: %s", o.OracleDid, err)
+	} else if err := o.ValidateProofScheme(); err != nil {
+		return err
+	}
+	return o.ValidateLimits()
+}
+
+// ValidateProofScheme checks that the proof scheme is known and that it has
+// the key that it needs
+func (o Oracle) ValidateProofScheme() error {
+	switch o.ProofScheme {
+	case ProofSchemeNone:
+		if o.ProofKey != "" {
+			return fmt.Errorf("oracle %s has a proof key but no proof scheme", o.OracleDid)
+		}
+	case ProofSchemeEd25519:
+		if len(base58.Decode(o.ProofKey)) != ed25519.PubKeyEd25519Size {
+			return fmt.Errorf("oracle %s has an invalid ed25519 proof key", o.OracleDid)
+		}
+	default:
+		return fmt.Errorf("oracle %s has an invalid proof scheme: %s", o.OracleDid, o.ProofScheme)
+	}
+	return nil
+}
+
+// ValidateLimits checks that the mint and burn limits are valid and that they
+// are only set on tokens that the oracle can mint or burn
+func (o Oracle) ValidateLimits() error {
+	for _, oc := range o.Capabilities {
+		for _, cap := range []TokenCap{MintCap, BurnCap} {
+			limit := oc.Limit(cap)
+			if limit == nil {
+				continue
+			} else if !oc.Capabilities.Includes(cap) {
+				return fmt.Errorf("oracle %s has a %s limit on %s without the capability",
+					o.OracleDid, cap, oc.Denom)
+			} else if err := limit.Validate(); err != nil {
+				return fmt.Errorf("oracle %s has an invalid %s limit on %s: %s",
+					o.OracleDid, cap, oc.Denom, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (os Oracles) Includes(oracle Oracle) bool {
 	for _, o := range os {
 		if oracle.OracleDid == o.OracleDid {
@@ -36,8 +103,10 @@ func (os Oracles) Includes(oracle Oracle) bool {
 
 type (
 	OracleTokenCap struct {
-		Denom        string    `json:"denom" yaml:"denom"`
-		Capabilities TokenCaps `json:"capabilities" yaml:"capabilities"`
+		Denom        string      `json:"denom" yaml:"denom"`
+		Capabilities TokenCaps   `json:"capabilities" yaml:"capabilities"`
+		MintLimit    *TokenLimit `json:"mint_limit,omitempty" yaml:"mint_limit,omitempty"` // no limit if nil
+		BurnLimit    *TokenLimit `json:"burn_limit,omitempty" yaml:"burn_limit,omitempty"` // no limit if nil
 	}
 	OracleTokenCaps []OracleTokenCap
 )
@@ -49,6 +118,58 @@ func NewOracleTokenCap(denom string, caps TokenCaps) OracleTokenCap {
 	}
 }
 
+// Limit returns the limit on minting or burning the token, or nil if there is
+// no limit
+func (oc OracleTokenCap) Limit(cap TokenCap) *TokenLimit {
+	switch cap {
+	case MintCap:
+		return oc.MintLimit
+	case BurnCap:
+		return oc.BurnLimit
+	default:
+		return nil
+	}
+}
+
+func (oc OracleTokenCap) String() string {
+	return fmt.Sprintf("%s:%s", oc.Denom, oc.Capabilities)
+}
+
+// Validate checks that there is at least one token, that every token has
+// valid capabilities, and that no token is listed twice
+func (otcs OracleTokenCaps) Validate() error {
+	if len(otcs) == 0 {
+		return fmt.Errorf("no capabilities")
+	}
+
+	seen := make(map[string]bool)
+	for _, oc := range otcs {
+		if len(oc.Denom) == 0 {
+			return fmt.Errorf("capability with an empty token")
+		} else if seen[oc.Denom] {
+			return fmt.Errorf("duplicate capabilities for %s", oc.Denom)
+		} else if len(oc.Capabilities) == 0 {
+			return fmt.Errorf("no capabilities for %s", oc.Denom)
+		}
+		for _, cap := range oc.Capabilities {
+			if !cap.IsValid() {
+				return fmt.Errorf("invalid capability for %s: %s", oc.Denom, cap)
+			}
+		}
+		seen[oc.Denom] = true
+	}
+	return nil
+}
+
+// String returns the capabilities in the form that ParseOracleTokenCaps parses
+func (otcs OracleTokenCaps) String() string {
+	capStrs := make([]string, len(otcs))
+	for i, oc := range otcs {
+		capStrs[i] = oc.String()
+	}
+	return strings.Join(capStrs, ",")
+}
+
 func (otcs OracleTokenCaps) Includes(denom string) bool {
 	for _, oc := range otcs {
 		if oc.Denom == denom {
@@ -67,6 +188,16 @@ func (otcs OracleTokenCaps) MustGet(denom string) OracleTokenCap {
 	panic("capability for specified denom not found")
 }
 
+// IncludesCap checks if the capability is included for any token
+func (otcs OracleTokenCaps) IncludesCap(cap TokenCap) bool {
+	for _, oc := range otcs {
+		if oc.Capabilities.Includes(cap) {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseTokenCaps(capsStr string) (TokenCaps, error) {
 	capsStr = strings.TrimSpace(capsStr)
 	capsStrs := strings.Split(capsStr, "/")
@@ -127,6 +258,88 @@ func ParseOracleTokenCaps(capsStr string) (OracleTokenCaps, error) {
 	return caps, nil
 }
 
+// --------------------------------------- TokenLimit
+
+// TokenLimit limits the amount of a token that an oracle can mint or burn in a
+// single tx, and within a rolling window of the last WindowBlocks blocks. A
+// zero maximum is no limit.
+type TokenLimit struct {
+	MaxPerTx     sdk.Int `json:"max_per_tx" yaml:"max_per_tx"`
+	MaxPerWindow sdk.Int `json:"max_per_window" yaml:"max_per_window"`
+	WindowBlocks int64   `json:"window_blocks" yaml:"window_blocks"`
+}
+
+func NewTokenLimit(maxPerTx, maxPerWindow sdk.Int, windowBlocks int64) TokenLimit {
+	return TokenLimit{
+		MaxPerTx:     maxPerTx,
+		MaxPerWindow: maxPerWindow,
+		WindowBlocks: windowBlocks,
+	}
+}
+
+func (l TokenLimit) Validate() error {
+	if l.MaxPerTx == (sdk.Int{}) || l.MaxPerWindow == (sdk.Int{}) {
+		return fmt.Errorf("limit maximums must be set")
+	} else if l.MaxPerTx.IsNegative() || l.MaxPerWindow.IsNegative() {
+		return fmt.Errorf("limit maximums cannot be negative")
+	} else if l.MaxPerWindow.IsPositive() && l.WindowBlocks <= 0 {
+		return fmt.Errorf("limit window must be at least one block")
+	}
+	return nil
+}
+
+// ParseTokenLimit parses a limit of the form
+// <max-per-tx>/<max-per-window>/<window-blocks>
+func ParseTokenLimit(limitStr string) (TokenLimit, error) {
+	limitStrs := strings.Split(strings.TrimSpace(limitStr), "/")
+	if len(limitStrs) != 3 {
+		return TokenLimit{}, fmt.Errorf("invalid limit: %s", limitStr)
+	}
+
+	maxPerTx, ok := sdk.NewIntFromString(limitStrs[0])
+	if !ok {
+		return TokenLimit{}, fmt.Errorf("invalid limit max per tx: %s", limitStrs[0])
+	}
+	maxPerWindow, ok := sdk.NewIntFromString(limitStrs[1])
+	if !ok {
+		return TokenLimit{}, fmt.Errorf("invalid limit max per window: %s", limitStrs[1])
+	}
+	windowBlocks, err := strconv.ParseInt(limitStrs[2], 10, 64)
+	if err != nil {
+		return TokenLimit{}, fmt.Errorf("invalid limit window: %s", limitStrs[2])
+	}
+
+	limit := NewTokenLimit(maxPerTx, maxPerWindow, windowBlocks)
+	return limit, limit.Validate()
+}
+
+// ParseTokenLimits parses limits of the form
+// <denom>=<limit>[,<denom>=<limit>] into limits by denom
+func ParseTokenLimits(limitsStr string) (map[string]TokenLimit, error) {
+	limitsStr = strings.TrimSpace(limitsStr)
+	if len(limitsStr) == 0 {
+		return nil, nil
+	}
+
+	limits := make(map[string]TokenLimit)
+	for _, denomLimitStr := range strings.Split(limitsStr, ",") {
+		denomLimit := strings.Split(strings.TrimSpace(denomLimitStr), "=")
+		if len(denomLimit) != 2 || len(denomLimit[0]) == 0 {
+			return nil, fmt.Errorf("invalid token limit: %s", denomLimitStr)
+		} else if _, ok := limits[denomLimit[0]]; ok {
+			return nil, fmt.Errorf("duplicate token limit: %s", denomLimit[0])
+		}
+
+		limit, err := ParseTokenLimit(denomLimit[1])
+		if err != nil {
+			return nil, err
+		}
+		limits[denomLimit[0]] = limit
+	}
+
+	return limits, nil
+}
+
 // --------------------------------------- TokenCap/s
 
 type (
@@ -134,6 +347,14 @@ type (
 	TokenCaps []TokenCap
 )
 
+func (tcs TokenCaps) String() string {
+	capStrs := make([]string, len(tcs))
+	for i, tc := range tcs {
+		capStrs[i] = string(tc)
+	}
+	return strings.Join(capStrs, "/")
+}
+
 func (tcs TokenCaps) Includes(cap TokenCap) bool {
 	for _, tc := range tcs {
 		if tc == cap {
@@ -143,12 +364,28 @@ func (tcs TokenCaps) Includes(cap TokenCap) bool {
 	return false
 }
 
+// An oracle with the compliance capability for any token may manage the
+// treasury blacklist. An oracle with the price feed capability for a token may
+// vote on the price of the token in any other token.
 const (
-	MintCap     TokenCap = "mint"
-	BurnCap     TokenCap = "burn"
-	TransferCap TokenCap = "transfer"
+	MintCap       TokenCap = "mint"
+	BurnCap       TokenCap = "burn"
+	TransferCap   TokenCap = "transfer"
+	ComplianceCap TokenCap = "compliance"
+	PriceFeedCap  TokenCap = "price_feed"
 )
 
 func (tc TokenCap) IsValid() bool {
-	return tc == MintCap || tc == BurnCap || tc == TransferCap
+	return tc == MintCap || tc == BurnCap || tc == TransferCap || tc == ComplianceCap || tc == PriceFeedCap
 }
+
+// --------------------------------------- ProofScheme
+
+// ProofScheme is how the proof of an oracle msg is checked. The proofs of an
+// oracle without a proof scheme are not checked.
+type ProofScheme string
+
+const (
+	ProofSchemeNone    ProofScheme = ""
+	ProofSchemeEd25519 ProofScheme = "ed25519"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func NewMsgSubmitPrice(base, quote string, price sdk.Dec, oracleDid exported.Did) MsgSubmitPrice {
+	return MsgSubmitPrice{
+		OracleDid: oracleDid,
+		Base:      base,
+		Quote:     quote,
+		Price:     price,
+	}
+}
+
+func NewMsgBondOracle(amount sdk.Coin, oracleDid exported.Did) MsgBondOracle {
+	return MsgBondOracle{
+		OracleDid: oracleDid,
+		Amount:    amount,
+	}
+}
+
+func NewMsgUnbondOracle(amount sdk.Coin, oracleDid exported.Did) MsgUnbondOracle {
+	return MsgUnbondOracle{
+		OracleDid: oracleDid,
+		Amount:    amount,
+	}
+}
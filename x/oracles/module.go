@@ -36,7 +36,12 @@ func (AppModuleBasic) DefaultGenesis() json.RawMessage {
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
@@ -44,7 +49,21 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 }
 
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	oraclesTxCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "oracles transaction sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oraclesTxCmd.AddCommand(flags.PostCommands(
+		cli.GetCmdSubmitPrice(cdc),
+		cli.GetCmdBondOracle(cdc),
+		cli.GetCmdUnbondOracle(cdc),
+	)...)
+
+	return oraclesTxCmd
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
@@ -58,6 +77,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	oraclesQueryCmd.AddCommand(flags.GetCommands(
 		cli.GetOraclesRequestHandler(cdc),
+		cli.GetParamsRequestHandler(cdc),
+		cli.GetCmdExchangeRate(cdc),
+		cli.GetCmdExchangeRates(cdc),
+		cli.GetCmdPriceVotes(cdc),
+		cli.GetCmdOracleBond(cdc),
+		cli.GetCmdUnbondings(cdc),
 	)...)
 
 	return oraclesQueryCmd
@@ -88,7 +113,7 @@ func (AppModule) Route() string {
 }
 
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper)
 }
 
 func (AppModule) QuerierRoute() string {
@@ -114,6 +139,6 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 }
 
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return []abci.ValidatorUpdate{}
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return EndBlocker(ctx, am.keeper)
 }
@@ -127,7 +127,7 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec) {
 
 	accountKeeper := auth.NewAccountKeeper(cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), nil)
-	didKeeper := did.NewKeeper(cdc, didKey)
+	didKeeper := did.NewKeeper(cdc, didKey, pk1.Subspace(did.DefaultParamspace))
 
 	keeper := NewKeeper(cdc, storeKey, paymentsSubspace, bankKeeper, didKeeper, nil)
 
@@ -2,7 +2,6 @@ package project
 
 import (
 	"fmt"
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -11,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tokenchain/dp-hub/x/dap"
 	"github.com/tokenchain/dp-hub/x/dap/types"
 	"github.com/tokenchain/dp-hub/x/did"
@@ -75,27 +73,37 @@ func GetPubKeyGetter(keeper Keeper, didKeeper did.Keeper) dap.PubKeyGetter {
 func GetPubKeyGetter(keeper Keeper, didKeeper did.Keeper) aute2.PubKeyGetter {
 	return func(ctx sdk.Context, msg aute2.IxoMsg) (pubKey crypto.PubKey, res error) {
 
-		// Get signer PubKey
-		var pubKeyEd25519 ed25519.PubKeyEd25519
+		// Get signer PubKey, which is of the key type the project declares
 		switch msg := msg.(type) {
 		case MsgCreateProject:
-			copy(pubKeyEd25519[:], base58.Decode(msg.GetPubKey()))
+			return export2.VerifyKeyToPubKey(msg.GetPubKey(), msg.KeyType)
 		case MsgWithdrawFunds:
-			signerDid := msg.GetSignerDid()
-			signerDoc, _ := didKeeper.GetDidDoc(ctx, signerDid)
-			if signerDoc == nil {
-				return pubKey, Unauthorized("signer did not found")
-			}
-			copy(pubKeyEd25519[:], base58.Decode(signerDoc.GetPubKey()))
+			return did.SignerPubKey(ctx, didKeeper, msg.GetSignerDid())
 		default:
 			// For the remaining messages, the project is the signer
 			projectDoc, err := keeper.GetProjectDoc(ctx, msg.GetSignerDid())
 			if err != nil {
 				return pubKey, IntErr("project did not found")
 			}
-			copy(pubKeyEd25519[:], base58.Decode(projectDoc.GetPubKey()))
+			projectKey, err := export2.VerifyKeyToPubKey(projectDoc.GetPubKey(), projectDoc.GetKeyType())
+			if err != nil {
+				return pubKey, err
+			}
+			// a controller or the multisig key set in the DID document of the
+			// project may sign instead, if the project key registered that
+			// document and so authorised them
+			trusted := did.HasPubKey(ctx, didKeeper, msg.GetSignerDid(), projectKey)
+			if _, controlled := aute2.SignatureController(ctx); controlled {
+				if !trusted {
+					return pubKey, Unauthorized("the did document of the project is not registered with the project key")
+				}
+				return did.SignerPubKey(ctx, didKeeper, msg.GetSignerDid())
+			}
+			if trusted && did.HasKeySet(ctx, didKeeper, msg.GetSignerDid()) {
+				return did.SignerPubKey(ctx, didKeeper, msg.GetSignerDid())
+			}
+			return projectKey, nil
 		}
-		return pubKeyEd25519, nil
 	}
 }
 
@@ -191,6 +199,16 @@ func NewProjectCreationAnteHandler(ak auth.AccountKeeper, sk supply.Keeper,
 				return newCtx, res
 			}*/
 
+		// project creation cannot be batched with other messages
+		if len(ixoTx.GetMsgs()) != 1 {
+			return newCtx, export2.IntErr("number of messages must be 1")
+		}
+
+		// the sender pays the project funding, which no fee allowance covers
+		if ixoTx.FeeGranter != "" {
+			return newCtx, export2.IntErr("project creation cannot be paid by a fee granter")
+		}
+
 		// message must be of type MsgCreateProject
 		msg, ok := ixoTx.GetMsgs()[0].(MsgCreateProject)
 		if !ok {
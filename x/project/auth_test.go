@@ -0,0 +1,119 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/project/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/project/internal/types"
+)
+
+func TestPubKeyGetterController(t *testing.T) {
+	ctx, k, cdc, _, _, didKeeper := keeper.CreateTestInputWithDidKeeper()
+	types.RegisterCodec(cdc)
+	getter := GetPubKeyGetter(k, didKeeper)
+	didHandler := did.NewHandler(didKeeper)
+
+	project := exported.NewDidGeneratorBuilder().Build()
+	controller := exported.NewDidGeneratorBuilder().Build()
+	attacker := exported.NewDidGeneratorBuilder().Build()
+	projectKey, err := project.VerifyPubKey()
+	require.Nil(t, err)
+	controllerKey, err := controller.VerifyPubKey()
+	require.Nil(t, err)
+
+	projectDoc := types.ValidCreateProjectMsg
+	projectDoc.ProjectDid = project.Did
+	projectDoc.PubKey = project.VerifyKey
+	k.SetProjectDoc(ctx, &projectDoc)
+	msg := types.MsgUpdateProjectStatus{SenderDid: project.Did, ProjectDid: project.Did}
+
+	// the project signs with its own key
+	pubKey, err := getter(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, projectKey, pubKey)
+
+	// a controller cannot sign for a project without a did document
+	controlled := ante.WithSignatureController(ctx, ante.IxoSignature{Controller: controller.Did})
+	_, err = getter(controlled, msg)
+	require.NotNil(t, err)
+
+	// a controller named in the did document of the project signs with its key
+	for _, id := range []exported.IxoDid{project, controller} {
+		_, err = didHandler(ctx, did.NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType))
+		require.Nil(t, err)
+	}
+	_, err = didHandler(ctx, did.NewMsgAddController(project.Did, controller.Did))
+	require.Nil(t, err)
+	pubKey, err = getter(controlled, msg)
+	require.Nil(t, err)
+	require.Equal(t, controllerKey, pubKey)
+
+	// a did document of the project registered with another key is not
+	// trusted, even if it names the controller
+	ctx, k, cdc, _, _, didKeeper = keeper.CreateTestInputWithDidKeeper()
+	types.RegisterCodec(cdc)
+	getter = GetPubKeyGetter(k, didKeeper)
+	didHandler = did.NewHandler(didKeeper)
+	k.SetProjectDoc(ctx, &projectDoc)
+	_, err = didHandler(ctx, did.NewMsgAddDid(project.Did, attacker.VerifyKey, attacker.KeyType))
+	require.Nil(t, err)
+	_, err = didHandler(ctx, did.NewMsgAddDid(attacker.Did, attacker.VerifyKey, attacker.KeyType))
+	require.Nil(t, err)
+	_, err = didHandler(ctx, did.NewMsgAddController(project.Did, attacker.Did))
+	require.Nil(t, err)
+
+	controlled = ante.WithSignatureController(ctx, ante.IxoSignature{Controller: attacker.Did})
+	_, err = getter(controlled, msg)
+	require.NotNil(t, err)
+	pubKey, err = getter(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, projectKey, pubKey)
+}
+
+func TestPubKeyGetterKeySet(t *testing.T) {
+	ctx, k, cdc, _, _, didKeeper := keeper.CreateTestInputWithDidKeeper()
+	types.RegisterCodec(cdc)
+	getter := GetPubKeyGetter(k, didKeeper)
+	didHandler := did.NewHandler(didKeeper)
+
+	project := exported.NewDidGeneratorBuilder().Build()
+	attacker := exported.NewDidGeneratorBuilder().Build()
+	projectKey, err := project.VerifyPubKey()
+	require.Nil(t, err)
+
+	projectDoc := types.ValidCreateProjectMsg
+	projectDoc.ProjectDid = project.Did
+	projectDoc.PubKey = project.VerifyKey
+	k.SetProjectDoc(ctx, &projectDoc)
+	msg := types.MsgUpdateProjectStatus{SenderDid: project.Did, ProjectDid: project.Did}
+
+	// a key set in a did document of the project registered with another key
+	// is not honoured
+	keySet := exported.NewMultisigKeySet(1, []string{attacker.VerifyKey})
+	addDid := did.NewMsgAddDid(project.Did, attacker.VerifyKey, attacker.KeyType)
+	addDid.DidDoc.KeySet = &keySet
+	_, err = didHandler(ctx, addDid)
+	require.Nil(t, err)
+	pubKey, err := getter(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, projectKey, pubKey)
+
+	// a key set in the did document registered with the project key is
+	ctx, k, cdc, _, _, didKeeper = keeper.CreateTestInputWithDidKeeper()
+	types.RegisterCodec(cdc)
+	getter = GetPubKeyGetter(k, didKeeper)
+	didHandler = did.NewHandler(didKeeper)
+	k.SetProjectDoc(ctx, &projectDoc)
+	_, err = didHandler(ctx, did.NewMsgAddDid(project.Did, project.VerifyKey, project.KeyType))
+	require.Nil(t, err)
+	_, err = didHandler(ctx, did.NewMsgSetKeySet(project.Did, &keySet))
+	require.Nil(t, err)
+	pubKey, err = getter(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, keySet.PubKey(), pubKey)
+}
@@ -18,6 +18,13 @@ import (
 
 func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	payments.Keeper, bank.Keeper) {
+	ctx, keeper, cdc, paymentsKeeper, bankKeeper, _ := CreateTestInputWithDidKeeper()
+	return ctx, keeper, cdc, paymentsKeeper, bankKeeper
+}
+
+// CreateTestInputWithDidKeeper also returns the did keeper of the project keeper
+func CreateTestInputWithDidKeeper() (sdk.Context, Keeper, *codec.Codec,
+	payments.Keeper, bank.Keeper, did.Keeper) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
 	didKey := sdk.NewKVStoreKey(did.StoreKey)
@@ -29,6 +36,7 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(didKey, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyFees, sdk.StoreTypeIAVL, nil)
@@ -46,12 +54,12 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec,
 	projectSubspace := pk1.Subspace(types.DefaultParamspace)
 
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), nil)
-	didKeeper := did.NewKeeper(cdc, didKey)
+	didKeeper := did.NewKeeper(cdc, didKey, pk1.Subspace(did.DefaultParamspace))
 	paymentsKeeper := payments.NewKeeper(cdc, keyFees, paymentsSubspace, bankKeeper, didKeeper, nil)
 	keeper := NewKeeper(cdc, storeKey, projectSubspace, accountKeeper, paymentsKeeper, didKeeper)
 	paymentsKeeper.SetParams(ctx, payments.DefaultParams())
 
-	return ctx, keeper, cdc, paymentsKeeper, bankKeeper
+	return ctx, keeper, cdc, paymentsKeeper, bankKeeper, didKeeper
 }
 
 func MakeTestCodec() *codec.Codec {
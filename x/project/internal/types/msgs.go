@@ -41,6 +41,7 @@ type MsgCreateProject struct {
 	SenderDid  exported.Did `json:"senderDid" yaml:"senderDid"`
 	ProjectDid exported.Did `json:"projectDid" yaml:"projectDid"`
 	PubKey     string       `json:"pubKey" yaml:"pubKey"`
+	KeyType    string       `json:"keyType,omitempty" yaml:"keyType"` // of PubKey, ed25519 if empty
 	Data       ProjectDoc   `json:"data" yaml:"data"`
 }
 
@@ -86,6 +87,13 @@ func (msg MsgCreateProject) ValidateBasic() error {
 		return exported.ErrInvalidDid("sender did is invalid")
 	}
 
+	// Check the key against its type if one is declared
+	if msg.KeyType != "" {
+		if err := exported.ValidateVerifyKey(msg.PubKey, msg.KeyType); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -97,6 +105,7 @@ func (msg MsgCreateProject) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgCreateProject) GetPubKey() string        { return msg.PubKey }
+func (msg MsgCreateProject) GetKeyType() string       { return exported.KeyTypeOrDefault(msg.KeyType) }
 func (msg MsgCreateProject) GetEvaluatorPay() int64   { return msg.Data.GetEvaluatorPay() }
 func (msg MsgCreateProject) GetStatus() ProjectStatus { return msg.Data.Status }
 func (msg *MsgCreateProject) SetStatus(status ProjectStatus) {
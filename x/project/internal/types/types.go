@@ -23,6 +23,7 @@ type StoredProjectDoc interface {
 	GetProjectDid() did.Did
 	GetSenderDid() did.Did
 	GetPubKey() string
+	GetKeyType() string
 	GetStatus() ProjectStatus
 	SetStatus(status ProjectStatus)
 }
@@ -12,6 +12,7 @@ func NewMsgCreateProject(senderDid exported.Did, projectDoc ProjectDoc, projectD
 		SenderDid:  senderDid,
 		ProjectDid: projectDid.Did,
 		PubKey:     projectDid.GetPubKey(),
+		KeyType:    projectDid.KeyType,
 		Data:       projectDoc,
 	}
 }
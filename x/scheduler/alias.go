@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
+	QuerierRoute      = types.QuerierRoute
+	RouterKey         = types.RouterKey
+	StoreKey          = types.StoreKey
+
+	TypeMsgScheduleTx        = types.TypeMsgScheduleTx
+	TypeMsgCancelScheduledTx = types.TypeMsgCancelScheduledTx
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+
+	ScheduledTx = types.ScheduledTx
+
+	MsgScheduleTx        = types.MsgScheduleTx
+	MsgCancelScheduledTx = types.MsgCancelScheduledTx
+)
+
+var (
+	// function aliases
+	NewKeeper     = keeper.NewKeeper
+	NewQuerier    = keeper.NewQuerier
+	RegisterCodec = types.RegisterCodec
+	DefaultParams = types.DefaultParams
+
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewScheduledTx          = types.NewScheduledTx
+	NewMsgScheduleTx        = types.NewMsgScheduleTx
+	NewMsgCancelScheduledTx = types.NewMsgCancelScheduledTx
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/tokenchain/dp-hub/client/utils"
+
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+func GetParamsRequestHandler(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+				keeper.QueryParams)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func GetCmdScheduledTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scheduled-tx [id]",
+		Short: "Query a scheduled tx that has not been executed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryScheduledTx, args[0])
+			if err != nil {
+				return err
+			}
+
+			var out types.ScheduledTx
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdScheduledTxs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scheduled-txs [submitter-did]",
+		Short: "Query the scheduled txs of a submitter, or all scheduled txs",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryScheduledTxs)
+			if len(args) == 1 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out []types.ScheduledTx
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
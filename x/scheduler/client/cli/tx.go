@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+// parseExecuteAt parses a block height, or a time in RFC3339 format
+func parseExecuteAt(executeAt string) (int64, time.Time, error) {
+	if height, err := strconv.ParseInt(executeAt, 10, 64); err == nil {
+		return height, time.Time{}, nil
+	}
+	executeTime, err := time.Parse(time.RFC3339, executeAt)
+	if err != nil {
+		return 0, time.Time{}, types.ErrInvalidScheduledTx("execute-at must be a height or an RFC3339 time")
+	}
+	return 0, executeTime.UTC(), nil
+}
+
+func GetCmdScheduleTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule-tx [msg-json] [execute-at] [fee-escrow] [submitter-dap-did-full]",
+		Short: "Schedule a msg signed by the submitter for execution at a height or an RFC3339 time",
+		Example: `dpcli tx scheduler schedule-tx '{"type":"treasury/MsgSend","value":{...}}' 120000 100000mdap "$SUBMITTER"
+dpcli tx scheduler schedule-tx '{"type":"treasury/MsgSend","value":{...}}' 2021-01-01T00:00:00Z 100000mdap "$SUBMITTER"`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var msg sdk.Msg
+			if err := cdc.UnmarshalJSON([]byte(args[0]), &msg); err != nil {
+				return err
+			}
+
+			executeHeight, executeTime, err := parseExecuteAt(args[1])
+			if err != nil {
+				return err
+			}
+
+			feeEscrow, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(args[3])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(ixoDid.Address())
+			schedule := types.NewMsgScheduleTx(msg, executeHeight, executeTime, feeEscrow, ixoDid.Did)
+			return ante.NewDidTxBuild(cliCtx, schedule, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdCancelScheduledTx(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-scheduled-tx [id] [submitter-dap-did-full]",
+		Short: "Cancel a scheduled tx before its execution and refund its fee escrow",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(args[1])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(ixoDid.Address())
+			msg := types.NewMsgCancelScheduledTx(id, ixoDid.Did)
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
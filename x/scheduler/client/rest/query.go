@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/tokenchain/dp-hub/client/utils"
+
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/scheduler/params",
+		queryParamsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/scheduler/txs/{%s}", RestScheduledTxId),
+		queryScheduledTxHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/scheduler/txs",
+		queryScheduledTxsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/scheduler/submitters/{%s}/txs", RestSubmitterDid),
+		queryScheduledTxsHandler(cliCtx)).Methods("GET")
+}
+
+func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var params types.Params
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
+
+func queryScheduledTxHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryScheduledTx, vars[RestScheduledTxId])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		var tx types.ScheduledTx
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &tx); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, tx)
+	}
+}
+
+func queryScheduledTxsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryScheduledTxs)
+		if submitterDid := vars[RestSubmitterDid]; submitterDid != "" {
+			route = fmt.Sprintf("%s/%s", route, submitterDid)
+		}
+		bz, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var txs []types.ScheduledTx
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &txs); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, txs)
+	}
+}
@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+const (
+	RestScheduledTxId = "scheduled_tx_id"
+	RestSubmitterDid  = "submitter_did"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new scheduler genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// Init params
+	keeper.SetParams(ctx, data.Params)
+
+	// Init scheduled txs, whose fee escrows are held by the module account
+	for _, tx := range data.ScheduledTxs {
+		keeper.SetScheduledTx(ctx, tx)
+	}
+	keeper.SetNextId(ctx, data.NextId)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetScheduledTxs(ctx, ""), keeper.GetNextId(ctx))
+}
@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+// EndBlocker executes the scheduled txs that are due. A failing msg does not
+// fail the block; its failure is emitted as an event instead.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	for _, tx := range k.GetDueScheduledTxs(ctx, k.GetParams(ctx).MaxExecutionsPerBlock) {
+		result := types.AttributeValueSuccess
+		var errMsg string
+		if err := k.ExecuteScheduledTx(ctx, tx); err != nil {
+			result = types.AttributeValueFailure
+			errMsg = err.Error()
+			ctx.Logger().Info(fmt.Sprintf("scheduled tx %d failed: %s", tx.Id, errMsg))
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteScheduledTx,
+				sdk.NewAttribute(types.AttributeKeyId, strconv.FormatUint(tx.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySubmitterDid, tx.SubmitterDid),
+				sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyResult, result),
+				sdk.NewAttribute(types.AttributeKeyError, errMsg),
+			),
+		)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgScheduleTx:
+			return handleMsgScheduleTx(ctx, k, msg)
+		case MsgCancelScheduledTx:
+			return handleMsgCancelScheduledTx(ctx, k, msg)
+		default:
+			return nil, exported.UnknownRequest("No match for message type.")
+		}
+	}
+}
+
+func handleMsgScheduleTx(ctx sdk.Context, k keeper.Keeper, msg types.MsgScheduleTx) (*sdk.Result, error) {
+	tx, err := k.ScheduleTx(ctx, msg.SubmitterDid, msg.Msg, msg.ExecuteHeight, msg.ExecuteTime, msg.FeeEscrow)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleTx,
+			sdk.NewAttribute(types.AttributeKeyId, strconv.FormatUint(tx.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySubmitterDid, tx.SubmitterDid),
+			sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatInt(tx.ExecuteHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecuteTime, tx.ExecuteTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelScheduledTx(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelScheduledTx) (*sdk.Result, error) {
+	if err := k.CancelScheduledTx(ctx, msg.Id, msg.SubmitterDid); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledTx,
+			sdk.NewAttribute(types.AttributeKeyId, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySubmitterDid, msg.SubmitterDid),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	supplyKeeper supply.Keeper
+	didKeeper    did.Keeper
+	router       sdk.Router
+}
+
+// NewKeeper returns a keeper that executes scheduled msgs with the handlers of
+// the router, which is the msg router of the app
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper supply.Keeper, didKeeper did.Keeper, router sdk.Router) Keeper {
+
+	// ensure the fee escrow module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the scheduler module account has not been set")
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper: supplyKeeper,
+		didKeeper:    didKeeper,
+		router:       router,
+	}
+}
+
+// GetParams returns the total set of scheduler parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of scheduler parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextId returns the id of the next scheduled tx
+func (k Keeper) GetNextId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextIdKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextIdKey, sdk.Uint64ToBigEndian(id))
+}
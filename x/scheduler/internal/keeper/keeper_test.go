@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+func TestScheduleAndCancel(t *testing.T) {
+	ctx, k, sk, _ := CreateTestInput()
+
+	// the fee escrow moves to the module account
+	tx, err := k.ScheduleTx(ctx, submitter.Did, testMsg{SignerDid: submitter.Did}, 10, time.Time{}, validFeeEscrow)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), tx.Id)
+	require.Equal(t, uint64(1), k.GetNextId(ctx))
+	require.Equal(t, validFeeEscrow, sk.GetModuleAccount(ctx, types.ModuleName).GetCoins())
+
+	stored, err := k.GetScheduledTx(ctx, tx.Id)
+	require.Nil(t, err)
+	require.Equal(t, tx, stored)
+	require.Len(t, k.GetScheduledTxs(ctx, submitter.Did), 1)
+	require.Len(t, k.GetScheduledTxs(ctx, other.Did), 0)
+
+	// only the submitter can cancel, and the escrow is refunded
+	require.NotNil(t, k.CancelScheduledTx(ctx, tx.Id, other.Did))
+	require.Nil(t, k.CancelScheduledTx(ctx, tx.Id, submitter.Did))
+	require.True(t, sk.GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
+	_, err = k.GetScheduledTx(ctx, tx.Id)
+	require.NotNil(t, err)
+	require.NotNil(t, k.CancelScheduledTx(ctx, tx.Id, submitter.Did))
+}
+
+func TestScheduleTxInvalid(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput()
+	msg := testMsg{SignerDid: submitter.Did}
+
+	// the execute height or time must be in the future
+	_, err := k.ScheduleTx(ctx, submitter.Did, msg, ctx.BlockHeight(), time.Time{}, validFeeEscrow)
+	require.NotNil(t, err)
+	_, err = k.ScheduleTx(ctx, submitter.Did, msg, 0, ctx.BlockTime(), validFeeEscrow)
+	require.NotNil(t, err)
+	_, err = k.ScheduleTx(ctx, submitter.Did, msg, 10, ctx.BlockTime().Add(time.Hour), validFeeEscrow)
+	require.NotNil(t, err)
+
+	// the msg must be signed by the submitter
+	_, err = k.ScheduleTx(ctx, other.Did, msg, 10, time.Time{}, validFeeEscrow)
+	require.NotNil(t, err)
+
+	// the escrow must be covered by the submitter
+	_, err = k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, initialCoins.Add(validFeeEscrow...))
+	require.NotNil(t, err)
+
+	// the route of the msg must be allowed
+	params := k.GetParams(ctx)
+	params.AllowedMsgRoutes = []string{"bonds"}
+	k.SetParams(ctx, params)
+	_, err = k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, validFeeEscrow)
+	require.NotNil(t, err)
+
+	require.Empty(t, k.GetScheduledTxs(ctx, ""))
+	require.Equal(t, uint64(0), k.GetNextId(ctx))
+}
+
+func TestGetDueScheduledTxs(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput()
+	msg := testMsg{SignerDid: submitter.Did}
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	byTime, err := k.ScheduleTx(ctx, submitter.Did, msg, 0, now.Add(time.Minute), nil)
+	require.Nil(t, err)
+	late, err := k.ScheduleTx(ctx, submitter.Did, msg, 20, time.Time{}, nil)
+	require.Nil(t, err)
+	early, err := k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, nil)
+	require.Nil(t, err)
+
+	require.Empty(t, k.GetDueScheduledTxs(ctx, 10))
+
+	// txs due at a height come first, in order of height
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(now.Add(time.Minute))
+	require.Equal(t, []types.ScheduledTx{early, late, byTime}, k.GetDueScheduledTxs(ctx, 10))
+	require.Equal(t, []types.ScheduledTx{early, late}, k.GetDueScheduledTxs(ctx, 2))
+
+	ctx = ctx.WithBlockHeight(15)
+	require.Equal(t, []types.ScheduledTx{early, byTime}, k.GetDueScheduledTxs(ctx, 10))
+}
+
+func TestExecuteScheduledTx(t *testing.T) {
+	ctx, k, sk, storeKey := CreateTestInput()
+	feeCollector := sk.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.True(t, feeCollector.GetCoins().IsZero())
+
+	tx, err := k.ScheduleTx(ctx, submitter.Did, testMsg{SignerDid: submitter.Did}, 10, time.Time{}, validFeeEscrow)
+	require.Nil(t, err)
+	failing, err := k.ScheduleTx(ctx, other.Did, testMsg{SignerDid: other.Did, Fail: true}, 10, time.Time{}, validFeeEscrow)
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.ExecuteScheduledTx(ctx, tx))
+	require.True(t, ctx.KVStore(storeKey).Has(executedKey(submitter.Did)))
+	events := ctx.EventManager().Events()
+	require.Equal(t, "test", events[len(events)-1].Type)
+
+	// the state changes of a failed msg are discarded
+	require.NotNil(t, k.ExecuteScheduledTx(ctx, failing))
+	require.False(t, ctx.KVStore(storeKey).Has(executedKey(other.Did)))
+
+	// the escrows are paid either way and the txs leave the schedule
+	require.Equal(t, validFeeEscrow.Add(validFeeEscrow...),
+		sk.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+	require.True(t, sk.GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
+	require.Empty(t, k.GetScheduledTxs(ctx, ""))
+	require.Empty(t, k.GetDueScheduledTxs(ctx, 10))
+}
+
+func TestScheduledTxCredentialRequirements(t *testing.T) {
+	ctx, k, _, storeKey := CreateTestInput()
+	msg := testMsg{SignerDid: submitter.Did}
+	requirement := exported.NewCredentialRequirement(exported.MsgTypeKey(msg), "KYCCredential", []exported.Did{other.Did})
+
+	// a msg that requires a credential the submitter lacks cannot be scheduled
+	k.didKeeper.SetParams(ctx, did.NewParams([]exported.CredentialRequirement{requirement}))
+	_, err := k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, nil)
+	require.NotNil(t, err)
+
+	credential := exported.DidCredential{
+		CredType: []string{"KYCCredential"},
+		Issuer:   other.Did,
+		Claim:    exported.NewClaim(submitter.Did, "schema", nil),
+	}
+	require.Nil(t, k.didKeeper.AddCredentials(ctx, submitter.Did, credential))
+	gated, err := k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, nil)
+	require.Nil(t, err)
+
+	// requirements that changed after scheduling are checked at execution
+	k.didKeeper.SetParams(ctx, did.NewParams(nil))
+	ungated, err := k.ScheduleTx(ctx, submitter.Did, msg, 10, time.Time{}, nil)
+	require.Nil(t, err)
+	requirement.Issuers = []exported.Did{submitter.Did}
+	k.didKeeper.SetParams(ctx, did.NewParams([]exported.CredentialRequirement{requirement}))
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NotNil(t, k.ExecuteScheduledTx(ctx, ungated))
+	require.False(t, ctx.KVStore(storeKey).Has(executedKey(submitter.Did)))
+	require.NotNil(t, k.ExecuteScheduledTx(ctx, gated))
+	require.False(t, ctx.KVStore(storeKey).Has(executedKey(submitter.Did)))
+}
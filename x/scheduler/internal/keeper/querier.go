@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+const (
+	QueryParams       = "queryParams"
+	QueryScheduledTx  = "queryScheduledTx"
+	QueryScheduledTxs = "queryScheduledTxs"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryScheduledTx:
+			return queryScheduledTx(ctx, path[1:], k)
+		case QueryScheduledTxs:
+			return queryScheduledTxs(ctx, path[1:], k)
+		default:
+			return nil, exported.UnknownRequest("unknown scheduler query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryScheduledTx(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, exported.UnknownRequest(fmt.Sprintf("invalid scheduled tx id '%s'", path[0]))
+	}
+
+	tx, err := k.GetScheduledTx(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, tx)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryScheduledTxs(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	var submitterDid exported.Did
+	if len(path) > 0 {
+		submitterDid = path[0]
+	}
+
+	txs := k.GetScheduledTxs(ctx, submitterDid)
+	if txs == nil {
+		txs = []types.ScheduledTx{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, txs)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+// -------------------------------------------------------- Scheduled txs Get/Set
+
+func (k Keeper) GetScheduledTxIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.ScheduledTxKeyPrefix)
+}
+
+func (k Keeper) MustGetScheduledTxByKey(ctx sdk.Context, key []byte) types.ScheduledTx {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		panic("scheduled tx not found")
+	}
+
+	bz := store.Get(key)
+	var tx types.ScheduledTx
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tx)
+
+	return tx
+}
+
+func (k Keeper) GetScheduledTx(ctx sdk.Context, id uint64) (types.ScheduledTx, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetScheduledTxKey(id)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.ScheduledTx{}, types.ErrScheduledTxNotFound(id)
+	}
+
+	var tx types.ScheduledTx
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tx)
+
+	return tx, nil
+}
+
+// GetScheduledTxs returns the scheduled txs of a submitter, or all scheduled
+// txs if the submitter is empty
+func (k Keeper) GetScheduledTxs(ctx sdk.Context, submitterDid exported.Did) []types.ScheduledTx {
+	var txs []types.ScheduledTx
+	iterator := k.GetScheduledTxIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		tx := k.MustGetScheduledTxByKey(ctx, iterator.Key())
+		if submitterDid == "" || tx.SubmitterDid == submitterDid {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+// SetScheduledTx sets a scheduled tx and queues it for execution
+func (k Keeper) SetScheduledTx(ctx sdk.Context, tx types.ScheduledTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledTxKey(tx.Id), k.cdc.MustMarshalBinaryLengthPrefixed(tx))
+	store.Set(queueKey(tx), sdk.Uint64ToBigEndian(tx.Id))
+}
+
+func (k Keeper) deleteScheduledTx(ctx sdk.Context, tx types.ScheduledTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledTxKey(tx.Id))
+	store.Delete(queueKey(tx))
+}
+
+func queueKey(tx types.ScheduledTx) []byte {
+	if tx.ExecuteHeight > 0 {
+		return types.GetHeightQueueKey(tx.ExecuteHeight, tx.Id)
+	}
+	return types.GetTimeQueueKey(tx.ExecuteTime, tx.Id)
+}
+
+// -------------------------------------------------------- Scheduling
+
+// ScheduleTx schedules a msg signed by the submitter for execution at a future
+// height or time, and moves the fee escrow of the submitter to the module
+// account
+func (k Keeper) ScheduleTx(ctx sdk.Context, submitterDid exported.Did, msg sdk.Msg,
+	executeHeight int64, executeTime time.Time, feeEscrow sdk.Coins) (types.ScheduledTx, error) {
+
+	if !k.GetParams(ctx).IsAllowedMsgRoute(msg.Route()) {
+		return types.ScheduledTx{}, types.ErrMsgRouteNotAllowed(msg.Route())
+	} else if err := k.checkCredentials(ctx, submitterDid, msg); err != nil {
+		return types.ScheduledTx{}, err
+	}
+
+	tx := types.NewScheduledTx(k.GetNextId(ctx), submitterDid, msg, executeHeight, executeTime, feeEscrow)
+	if err := tx.Validate(); err != nil {
+		return types.ScheduledTx{}, err
+	} else if tx.IsDue(ctx) {
+		return types.ScheduledTx{}, types.ErrInvalidScheduledTx("execute height or time must be in the future")
+	}
+
+	if !feeEscrow.IsZero() {
+		submitterAddr, err := k.submitterAddress(ctx, submitterDid)
+		if err != nil {
+			return types.ScheduledTx{}, err
+		}
+		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, submitterAddr, types.ModuleName, feeEscrow)
+		if err != nil {
+			return types.ScheduledTx{}, err
+		}
+	}
+
+	k.SetScheduledTx(ctx, tx)
+	k.SetNextId(ctx, tx.Id+1)
+	return tx, nil
+}
+
+// CancelScheduledTx cancels a scheduled tx that has not been executed yet and
+// refunds the fee escrow to the submitter
+func (k Keeper) CancelScheduledTx(ctx sdk.Context, id uint64, submitterDid exported.Did) error {
+	tx, err := k.GetScheduledTx(ctx, id)
+	if err != nil {
+		return err
+	} else if tx.SubmitterDid != submitterDid {
+		return exported.Unauthorized("only the submitter can cancel a scheduled tx")
+	}
+
+	if !tx.FeeEscrow.IsZero() {
+		submitterAddr, err := k.submitterAddress(ctx, submitterDid)
+		if err != nil {
+			return err
+		}
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, submitterAddr, tx.FeeEscrow)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.deleteScheduledTx(ctx, tx)
+	return nil
+}
+
+// checkCredentials checks that the submitter satisfies the credential
+// requirements of the msg type, which the ante handler would check if the msg
+// was sent directly
+func (k Keeper) checkCredentials(ctx sdk.Context, submitterDid exported.Did, msg sdk.Msg) error {
+	msgType := exported.MsgTypeKey(msg)
+	for _, cr := range k.didKeeper.GetCredentialRequirements(ctx, msgType) {
+		if !k.didKeeper.HasValidCredential(ctx, submitterDid, cr.CredType, cr.Issuers) {
+			return sdkerrors.Wrapf(exported.ErrUnauthorizedPermission, "%s requires a %s credential", msgType, cr.CredType)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) submitterAddress(ctx sdk.Context, submitterDid exported.Did) (sdk.AccAddress, error) {
+	didDoc, err := k.didKeeper.GetDidDoc(ctx, submitterDid)
+	if err != nil {
+		return nil, err
+	}
+	return didDoc.Address(), nil
+}
+
+// -------------------------------------------------------- Execution
+
+// GetDueScheduledTxs returns up to limit txs that are due in the block of the
+// context, txs due at a height first
+func (k Keeper) GetDueScheduledTxs(ctx sdk.Context, limit uint64) []types.ScheduledTx {
+	store := ctx.KVStore(k.storeKey)
+	queues := []sdk.Iterator{
+		store.Iterator(types.HeightQueueKeyPrefix,
+			sdk.PrefixEndBytes(types.GetHeightQueuePrefix(ctx.BlockHeight()))),
+		store.Iterator(types.TimeQueueKeyPrefix,
+			sdk.PrefixEndBytes(types.GetTimeQueuePrefix(ctx.BlockTime()))),
+	}
+
+	var txs []types.ScheduledTx
+	for _, iterator := range queues {
+		for ; iterator.Valid() && uint64(len(txs)) < limit; iterator.Next() {
+			id := binary.BigEndian.Uint64(iterator.Value())
+			txs = append(txs, k.MustGetScheduledTxByKey(ctx, types.GetScheduledTxKey(id)))
+		}
+		iterator.Close()
+	}
+	return txs
+}
+
+// ExecuteScheduledTx removes a due tx from the schedule, pays its fee escrow
+// to the fee collector and dispatches its msg to the handler of the msg route.
+// The credential requirements of the msg are checked again, as they or the
+// credentials of the submitter may have changed since it was scheduled.
+// The state changes of the msg are discarded if it fails, and the failure is
+// returned.
+func (k Keeper) ExecuteScheduledTx(ctx sdk.Context, tx types.ScheduledTx) error {
+	k.deleteScheduledTx(ctx, tx)
+
+	// the escrow pays for the execution whether or not the msg succeeds
+	if !tx.FeeEscrow.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, tx.FeeEscrow)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.dispatch(ctx, tx.SubmitterDid, tx.Msg)
+}
+
+func (k Keeper) dispatch(ctx sdk.Context, submitterDid exported.Did, msg sdk.Msg) (err error) {
+	handler := k.router.Route(ctx, msg.Route())
+	if handler == nil {
+		return exported.UnknownRequest(fmt.Sprintf("unrecognized message route: %s", msg.Route()))
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.checkCredentials(ctx, submitterDid, msg); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(k.GetParams(ctx).MaxExecutionGas))
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, r.Descriptor)
+			default:
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+	write()
+	if res != nil {
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/scheduler/internal/types"
+)
+
+const testMsgRoute = "treasury"
+
+var (
+	submitter = exported.NewDidGeneratorBuilder().Build()
+	other     = exported.NewDidGeneratorBuilder().Build()
+
+	validFeeEscrow, _ = sdk.ParseCoins("10dap")
+	initialCoins, _   = sdk.ParseCoins("100dap")
+)
+
+// testMsg is a msg of another module. Its handler records that it was
+// executed and then fails if the msg says so.
+type testMsg struct {
+	SignerDid exported.Did `json:"signer_did"`
+	Fail      bool         `json:"fail"`
+}
+
+func (msg testMsg) Route() string        { return testMsgRoute }
+func (msg testMsg) Type() string         { return "test" }
+func (msg testMsg) ValidateBasic() error { return nil }
+func (msg testMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg testMsg) GetSigners() []sdk.AccAddress { return nil }
+func (msg testMsg) GetSignerDid() exported.Did   { return msg.SignerDid }
+func (msg testMsg) String() string               { return "testMsg" }
+
+func executedKey(signerDid exported.Did) []byte {
+	return append([]byte("executed/"), signerDid...)
+}
+
+func newTestMsgHandler(storeKey sdk.StoreKey) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		m := msg.(testMsg)
+		ctx.KVStore(storeKey).Set(executedKey(m.SignerDid), []byte{1})
+		if m.Fail {
+			return nil, exported.IntErr("test msg failed")
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent("test"))
+		return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	}
+}
+
+func CreateTestInput() (sdk.Context, Keeper, supply.Keeper, sdk.StoreKey) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	didKey := sdk.NewKVStoreKey(did.StoreKey)
+	keyParams := sdk.NewKVStoreKey("subspace")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(didKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+
+	_ = ms.LoadLatestVersion()
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	module.NewBasicManager(auth.AppModuleBasic{}, supply.AppModuleBasic{}).RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	exported.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testMsg{}, "scheduler/testMsg", nil)
+
+	pk1 := params.NewKeeper(cdc, keyParams, tkeyParams)
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      nil,
+	}
+
+	accountKeeper := auth.NewAccountKeeper(cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	didKeeper := did.NewKeeper(cdc, didKey, pk1.Subspace(did.DefaultParamspace))
+
+	router := baseapp.NewRouter()
+	router.AddRoute(testMsgRoute, newTestMsgHandler(storeKey))
+
+	keeper := NewKeeper(cdc, storeKey, pk1.Subspace(types.DefaultParamspace), supplyKeeper, didKeeper, router)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	// the submitters have did docs and funded accounts
+	didHandler := did.NewHandler(didKeeper)
+	for _, id := range []exported.IxoDid{submitter, other} {
+		_, err := didHandler(ctx, did.NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType))
+		if err != nil {
+			panic(err)
+		}
+		if err := bankKeeper.SetCoins(ctx, id.Address(), initialCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	return ctx, keeper, supplyKeeper, storeKey
+}
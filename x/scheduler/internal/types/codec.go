@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgScheduleTx{}, "scheduler/MsgScheduleTx", nil)
+	cdc.RegisterConcrete(MsgCancelScheduledTx{}, "scheduler/MsgCancelScheduledTx", nil)
+}
+
+// ModuleCdc is the codec for the module. Scheduled txs carry the msgs of the
+// other modules, which the app registers before it seals the codec.
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	sdk.RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+}
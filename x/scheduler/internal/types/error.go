@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func ErrInvalidScheduledTx(errMsg string) error {
+	return errors.Wrap(exported.EInvalidScheduledTx, errMsg)
+}
+
+func ErrScheduledTxNotFound(id uint64) error {
+	errMsg := fmt.Sprintf("scheduled tx %d does not exist", id)
+	return errors.Wrap(exported.EScheduledTxNotFound, errMsg)
+}
+
+func ErrMsgRouteNotAllowed(route string) error {
+	errMsg := fmt.Sprintf("msgs of route %s cannot be scheduled", route)
+	return errors.Wrap(exported.EInvalidScheduledTx, errMsg)
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	EventTypeScheduleTx         = "schedule_tx"
+	EventTypeCancelScheduledTx  = "cancel_scheduled_tx"
+	EventTypeExecuteScheduledTx = "execute_scheduled_tx"
+
+	AttributeKeyId            = "id"
+	AttributeKeySubmitterDid  = "submitter_did"
+	AttributeKeyMsgType       = "msg_type"
+	AttributeKeyExecuteHeight = "execute_height"
+	AttributeKeyExecuteTime   = "execute_time"
+	AttributeKeyResult        = "result"
+	AttributeKeyError         = "error"
+
+	AttributeValueCategory = ModuleName
+	AttributeValueSuccess  = "success"
+	AttributeValueFailure  = "failure"
+)
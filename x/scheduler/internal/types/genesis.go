@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+type GenesisState struct {
+	Params       Params        `json:"params" yaml:"params"`
+	ScheduledTxs []ScheduledTx `json:"scheduled_txs" yaml:"scheduled_txs"`
+	NextId       uint64        `json:"next_id" yaml:"next_id"`
+}
+
+func NewGenesisState(params Params, scheduledTxs []ScheduledTx, nextId uint64) GenesisState {
+	return GenesisState{
+		Params:       params,
+		ScheduledTxs: scheduledTxs,
+		NextId:       nextId,
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	// Validate params
+	err := ValidateParams(data.Params)
+	if err != nil {
+		return err
+	}
+
+	// Validate scheduled txs, whose ids must all have been assigned
+	ids := make(map[uint64]bool, len(data.ScheduledTxs))
+	for _, tx := range data.ScheduledTxs {
+		if err := tx.Validate(); err != nil {
+			return err
+		}
+		if tx.Id >= data.NextId {
+			return fmt.Errorf("scheduled tx id %d is not below the next id %d", tx.Id, data.NextId)
+		} else if ids[tx.Id] {
+			return fmt.Errorf("duplicate scheduled tx id %d", tx.Id)
+		}
+		ids[tx.Id] = true
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:       DefaultParams(),
+		ScheduledTxs: nil,
+		NextId:       0,
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName        = "scheduler"
+	DefaultParamspace = ModuleName
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
+)
+
+var (
+	ScheduledTxKeyPrefix = []byte{0x01}
+	HeightQueueKeyPrefix = []byte{0x02}
+	TimeQueueKeyPrefix   = []byte{0x03}
+	NextIdKey            = []byte{0x04}
+)
+
+func GetScheduledTxKey(id uint64) []byte {
+	return append(ScheduledTxKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetHeightQueueKey returns the key of a scheduled tx in the queue of the txs
+// due at a height. Keys are ordered by height, then by id.
+func GetHeightQueueKey(height int64, id uint64) []byte {
+	return append(GetHeightQueuePrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetHeightQueuePrefix(height int64) []byte {
+	return append(HeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetTimeQueueKey returns the key of a scheduled tx in the queue of the txs
+// due at a time. Keys are ordered by time, then by id.
+func GetTimeQueueKey(t time.Time, id uint64) []byte {
+	return append(GetTimeQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetTimeQueuePrefix(t time.Time) []byte {
+	return append(TimeQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
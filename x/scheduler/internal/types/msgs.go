@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const (
+	TypeMsgScheduleTx        = "schedule-tx"
+	TypeMsgCancelScheduledTx = "cancel-scheduled-tx"
+)
+
+var (
+	_ ante.IxoMsg = MsgScheduleTx{}
+	_ ante.IxoMsg = MsgCancelScheduledTx{}
+)
+
+type MsgScheduleTx struct {
+	SubmitterDid  exported.Did `json:"submitter_did" yaml:"submitter_did"`
+	Msg           sdk.Msg      `json:"msg" yaml:"msg"`
+	ExecuteHeight int64        `json:"execute_height" yaml:"execute_height"`
+	ExecuteTime   time.Time    `json:"execute_time" yaml:"execute_time"`
+	FeeEscrow     sdk.Coins    `json:"fee_escrow" yaml:"fee_escrow"`
+}
+
+func NewMsgScheduleTx(msg sdk.Msg, executeHeight int64, executeTime time.Time,
+	feeEscrow sdk.Coins, submitterDid exported.Did) MsgScheduleTx {
+	return MsgScheduleTx{
+		SubmitterDid:  submitterDid,
+		Msg:           msg,
+		ExecuteHeight: executeHeight,
+		ExecuteTime:   executeTime,
+		FeeEscrow:     feeEscrow,
+	}
+}
+
+func (msg MsgScheduleTx) Type() string  { return TypeMsgScheduleTx }
+func (msg MsgScheduleTx) Route() string { return RouterKey }
+func (msg MsgScheduleTx) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.SubmitterDid, "SubmitterDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.SubmitterDid) {
+		return exported.ErrInvalidDid("submitter did is invalid")
+	}
+
+	return validateScheduledMsg(msg.SubmitterDid, msg.Msg, msg.ExecuteHeight, msg.ExecuteTime, msg.FeeEscrow)
+}
+
+func (msg MsgScheduleTx) GetSignerDid() exported.Did { return msg.SubmitterDid }
+func (msg MsgScheduleTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgScheduleTx) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// GetSignBytes includes the sign bytes of the scheduled msg, so that the
+// signature of the submitter also signs the scheduled msg
+func (msg MsgScheduleTx) GetSignBytes() []byte {
+	signDoc := struct {
+		SubmitterDid  exported.Did    `json:"submitter_did"`
+		MsgRoute      string          `json:"msg_route"`
+		MsgType       string          `json:"msg_type"`
+		Msg           json.RawMessage `json:"msg"`
+		ExecuteHeight int64           `json:"execute_height"`
+		ExecuteTime   time.Time       `json:"execute_time"`
+		FeeEscrow     sdk.Coins       `json:"fee_escrow"`
+	}{
+		SubmitterDid:  msg.SubmitterDid,
+		ExecuteHeight: msg.ExecuteHeight,
+		ExecuteTime:   msg.ExecuteTime,
+		FeeEscrow:     msg.FeeEscrow,
+	}
+	if msg.Msg != nil {
+		signDoc.MsgRoute = msg.Msg.Route()
+		signDoc.MsgType = msg.Msg.Type()
+		signDoc.Msg = msg.Msg.GetSignBytes()
+	}
+
+	if bz, err := json.Marshal(signDoc); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+type MsgCancelScheduledTx struct {
+	SubmitterDid exported.Did `json:"submitter_did" yaml:"submitter_did"`
+	Id           uint64       `json:"id" yaml:"id"`
+}
+
+func NewMsgCancelScheduledTx(id uint64, submitterDid exported.Did) MsgCancelScheduledTx {
+	return MsgCancelScheduledTx{
+		SubmitterDid: submitterDid,
+		Id:           id,
+	}
+}
+
+func (msg MsgCancelScheduledTx) Type() string  { return TypeMsgCancelScheduledTx }
+func (msg MsgCancelScheduledTx) Route() string { return RouterKey }
+func (msg MsgCancelScheduledTx) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.SubmitterDid, "SubmitterDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.SubmitterDid) {
+		return exported.ErrInvalidDid("submitter did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgCancelScheduledTx) GetSignerDid() exported.Did { return msg.SubmitterDid }
+func (msg MsgCancelScheduledTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgCancelScheduledTx) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgCancelScheduledTx) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
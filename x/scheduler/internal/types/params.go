@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyAllowedMsgRoutes      = []byte("AllowedMsgRoutes")
+	KeyMaxExecutionGas       = []byte("MaxExecutionGas")
+	KeyMaxExecutionsPerBlock = []byte("MaxExecutionsPerBlock")
+)
+
+// scheduler parameters
+type Params struct {
+	AllowedMsgRoutes      []string `json:"allowed_msg_routes" yaml:"allowed_msg_routes"`             // routes of the msgs that can be scheduled
+	MaxExecutionGas       uint64   `json:"max_execution_gas" yaml:"max_execution_gas"`               // gas limit of the execution of a scheduled msg
+	MaxExecutionsPerBlock uint64   `json:"max_executions_per_block" yaml:"max_executions_per_block"` // due txs beyond the limit run in the next blocks
+}
+
+// ParamTable for scheduler module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(allowedMsgRoutes []string, maxExecutionGas, maxExecutionsPerBlock uint64) Params {
+	return Params{
+		AllowedMsgRoutes:      allowedMsgRoutes,
+		MaxExecutionGas:       maxExecutionGas,
+		MaxExecutionsPerBlock: maxExecutionsPerBlock,
+	}
+}
+
+// default scheduler module parameters
+func DefaultParams() Params {
+	return Params{
+		AllowedMsgRoutes:      []string{"treasury", "bonds", "payments"},
+		MaxExecutionGas:       200000,
+		MaxExecutionsPerBlock: 100,
+	}
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAllowedMsgRoutes, &p.AllowedMsgRoutes, validateAllowedMsgRoutes),
+		params.NewParamSetPair(KeyMaxExecutionGas, &p.MaxExecutionGas, validatePositiveUint64),
+		params.NewParamSetPair(KeyMaxExecutionsPerBlock, &p.MaxExecutionsPerBlock, validatePositiveUint64),
+	}
+}
+
+// IsAllowedMsgRoute checks if the msgs of a route can be scheduled
+func (p Params) IsAllowedMsgRoute(route string) bool {
+	for _, r := range p.AllowedMsgRoutes {
+		if r == route {
+			return true
+		}
+	}
+	return false
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	if err := validateAllowedMsgRoutes(params.AllowedMsgRoutes); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64(params.MaxExecutionGas); err != nil {
+		return err
+	}
+	return validatePositiveUint64(params.MaxExecutionsPerBlock)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Scheduler Params:
+  Allowed Msg Routes:       %s
+  Max Execution Gas:        %d
+  Max Executions Per Block: %d
+`,
+		strings.Join(p.AllowedMsgRoutes, ", "), p.MaxExecutionGas, p.MaxExecutionsPerBlock,
+	)
+}
+
+func validateAllowedMsgRoutes(i interface{}) error {
+	routes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, route := range routes {
+		if !sdk.IsAlphaNumeric(route) {
+			return fmt.Errorf("scheduler parameter AllowedMsgRoutes has an invalid route %s", route)
+		} else if route == RouterKey {
+			return fmt.Errorf("scheduler parameter AllowedMsgRoutes cannot include %s", RouterKey)
+		}
+	}
+	return nil
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("scheduler parameter must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/ante"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// ScheduledTx is a msg signed by its submitter that is executed at a future
+// height or time. The fee escrow is held by the module account until the msg
+// is executed, when it goes to the fee collector, or cancelled, when it is
+// refunded to the submitter.
+type ScheduledTx struct {
+	Id            uint64       `json:"id" yaml:"id"`
+	SubmitterDid  exported.Did `json:"submitter_did" yaml:"submitter_did"`
+	Msg           sdk.Msg      `json:"msg" yaml:"msg"`
+	ExecuteHeight int64        `json:"execute_height" yaml:"execute_height"`
+	ExecuteTime   time.Time    `json:"execute_time" yaml:"execute_time"`
+	FeeEscrow     sdk.Coins    `json:"fee_escrow" yaml:"fee_escrow"`
+}
+
+func NewScheduledTx(id uint64, submitterDid exported.Did, msg sdk.Msg,
+	executeHeight int64, executeTime time.Time, feeEscrow sdk.Coins) ScheduledTx {
+	return ScheduledTx{
+		Id:            id,
+		SubmitterDid:  submitterDid,
+		Msg:           msg,
+		ExecuteHeight: executeHeight,
+		ExecuteTime:   executeTime,
+		FeeEscrow:     feeEscrow,
+	}
+}
+
+// IsDue checks if the tx is to be executed in the block of the context
+func (tx ScheduledTx) IsDue(ctx sdk.Context) bool {
+	if tx.ExecuteHeight > 0 {
+		return ctx.BlockHeight() >= tx.ExecuteHeight
+	}
+	return !ctx.BlockTime().Before(tx.ExecuteTime)
+}
+
+func (tx ScheduledTx) Validate() error {
+	if !exported.IsValidDid(tx.SubmitterDid) {
+		return exported.ErrInvalidDid("submitter did is invalid")
+	}
+	return validateScheduledMsg(tx.SubmitterDid, tx.Msg, tx.ExecuteHeight, tx.ExecuteTime, tx.FeeEscrow)
+}
+
+// validateScheduledMsg checks that the submitter signs for the msg and that
+// the msg is executed either at a height or at a time
+func validateScheduledMsg(submitterDid exported.Did, msg sdk.Msg,
+	executeHeight int64, executeTime time.Time, feeEscrow sdk.Coins) error {
+	if msg == nil {
+		return ErrInvalidScheduledTx("msg is empty")
+	}
+	ixoMsg, ok := msg.(ante.IxoMsg)
+	if !ok {
+		return ErrInvalidScheduledTx("msg must be signed by a did")
+	} else if ixoMsg.Route() == RouterKey {
+		return ErrInvalidScheduledTx("scheduler msgs cannot be scheduled")
+	} else if ixoMsg.GetSignerDid() != submitterDid {
+		return ErrInvalidScheduledTx(fmt.Sprintf("msg must be signed by the submitter %s", submitterDid))
+	}
+	if err := ixoMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if executeHeight < 0 {
+		return ErrInvalidScheduledTx("execute height is negative")
+	} else if (executeHeight > 0) == !executeTime.IsZero() {
+		return ErrInvalidScheduledTx("either an execute height or an execute time is required")
+	}
+
+	if !feeEscrow.IsValid() {
+		return exported.ErrInvalidCoins("fee escrow is invalid: " + feeEscrow.String())
+	}
+	return nil
+}
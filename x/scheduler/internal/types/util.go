@@ -0,0 +1,15 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func CheckNotEmpty(value string, name string) (valid bool, err error) {
+	if strings.TrimSpace(value) == "" {
+		return false, exported.UnknownRequest(name + " is empty.")
+	} else {
+		return true, nil
+	}
+}
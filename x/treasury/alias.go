@@ -6,27 +6,78 @@ import (
 )
 
 const (
-	ModuleName   = types.ModuleName
-	QuerierRoute = types.QuerierRoute
-	RouterKey    = types.RouterKey
-	StoreKey     = types.StoreKey
+	ModuleName        = types.ModuleName
+	DefaultParamspace = types.DefaultParamspace
+	QuerierRoute      = types.QuerierRoute
+	RouterKey         = types.RouterKey
+	StoreKey          = types.StoreKey
 
 	DefaultCodespace = types.DefaultCodespace
+
+	EscrowAccountName = types.EscrowAccountName
+
+	OperationSend = types.OperationSend
+	OperationMint = types.OperationMint
+	OperationBurn = types.OperationBurn
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	DenomFlags   = types.DenomFlags
+
+	MsgSend                = types.MsgSend
+	MsgMultiSend           = types.MsgMultiSend
+	MultiSendOutput        = types.MultiSendOutput
+	MsgOracleTransfer      = types.MsgOracleTransfer
+	MsgOracleMint          = types.MsgOracleMint
+	MsgOracleBurn          = types.MsgOracleBurn
+	MsgSetOperationEnabled = types.MsgSetOperationEnabled
+	MsgSetBlacklisted      = types.MsgSetBlacklisted
+	MsgCreateEscrow        = types.MsgCreateEscrow
+	MsgReleaseEscrow       = types.MsgReleaseEscrow
+	MsgRefundEscrow        = types.MsgRefundEscrow
+
+	BlacklistProposal = types.BlacklistProposal
 
-	MsgSend           = types.MsgSend
-	MsgOracleTransfer = types.MsgOracleTransfer
-	MsgOracleMint     = types.MsgOracleMint
-	MsgOracleBurn     = types.MsgOracleBurn
+	OracleProof = types.OracleProof
+	SpentProof  = types.SpentProof
+
+	OracleTotals   = types.OracleTotals
+	OracleActivity = types.OracleActivity
+	OracleUsage    = types.OracleUsage
+	Escrow         = types.Escrow
 )
 
 var (
 	// function aliases
 	NewKeeper     = keeper.NewKeeper
+	NewQuerier    = keeper.NewQuerier
 	RegisterCodec = types.RegisterCodec
+	NewParams     = types.NewParams
+	DefaultParams = types.DefaultParams
+
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewDenomFlags             = types.NewDenomFlags
+	NewMsgMultiSend           = types.NewMsgMultiSend
+	ParseMultiSendOutputs     = types.ParseMultiSendOutputs
+	NewMsgSetOperationEnabled = types.NewMsgSetOperationEnabled
+	NewMsgSetBlacklisted      = types.NewMsgSetBlacklisted
+	NewBlacklistProposal      = types.NewBlacklistProposal
+	NewMsgCreateEscrow        = types.NewMsgCreateEscrow
+	NewMsgReleaseEscrow       = types.NewMsgReleaseEscrow
+	NewMsgRefundEscrow        = types.NewMsgRefundEscrow
+	NewEscrow                 = types.NewEscrow
+	NewSpentProof             = types.NewSpentProof
+	ParseOracleProof          = types.ParseOracleProof
+	OracleProofSignBytes      = types.OracleProofSignBytes
+	NewOracleTotals           = types.NewOracleTotals
+	NewOracleActivity         = types.NewOracleActivity
+	NewOracleUsage            = types.NewOracleUsage
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
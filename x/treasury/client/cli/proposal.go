@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// GetCmdSubmitBlacklistProposal implements a command handler for submitting
+// a blacklist proposal transaction.
+func GetCmdSubmitBlacklistProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "treasury-blacklist [did-or-addr] [true|false] [title] [description] [deposit]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to add a DID or address to the treasury blacklist, or remove it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a treasury blacklist proposal along with an initial deposit.
+Passing false removes the DID or address from the blacklist.
+
+Example:
+$ %s tx gov submit-proposal treasury-blacklist did:dxp:VrsU9cUAcYgF7f397xtjsX true "Sanctioned" "Blacklist a sanctioned account" 1000mdap --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			blacklisted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[4])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewBlacklistProposal(args[2], args[3], args[0], blacklisted)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
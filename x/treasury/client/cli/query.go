@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tokenchain/dp-hub/client/utils"
+
+	"github.com/tokenchain/dp-hub/x/treasury/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+func GetParamsRequestHandler(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query params, including the enabled operations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+				keeper.QueryParams)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func GetCmdBlacklist(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "blacklist",
+		Short: "Query the blacklisted addresses",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+				keeper.QueryBlacklist)
+			if err != nil {
+				return err
+			}
+
+			var blacklist []sdk.AccAddress
+			if err := cdc.UnmarshalJSON(bz, &blacklist); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(blacklist)
+		},
+	}
+}
+
+func GetCmdOracleTotals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "totals [oracle-did]",
+		Short: "Query the totals minted and burned by an oracle, or by every oracle",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s", types.QuerierRoute,
+					keeper.QueryAllOracleTotals)
+				if err != nil {
+					return err
+				}
+
+				var totals []types.OracleTotals
+				if err := cdc.UnmarshalJSON(bz, &totals); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(totals)
+			}
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryOracleTotals, args[0])
+			if err != nil {
+				return err
+			}
+
+			var totals types.OracleTotals
+			if err := cdc.UnmarshalJSON(bz, &totals); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(totals)
+		},
+	}
+}
+
+func GetCmdOracleHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [oracle-did]",
+		Short: "Query the transfers, mints and burns performed by an oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryOracleHistory, args[0])
+			if err != nil {
+				return err
+			}
+
+			var activities []types.OracleActivity
+			if err := cdc.UnmarshalJSON(bz, &activities); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(activities)
+		},
+	}
+}
+
+func GetCmdEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrow [escrow-id]",
+		Short: "Query an open escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryEscrow, args[0])
+			if err != nil {
+				return err
+			}
+
+			var escrow types.Escrow
+			if err := cdc.UnmarshalJSON(bz, &escrow); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(escrow)
+		},
+	}
+}
+
+func GetCmdEscrows(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrows [party-did-or-addr]",
+		Short: "Query the open escrows of a sender, recipient or release DID, or every open escrow",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryEscrows)
+			if len(args) > 0 {
+				route = fmt.Sprintf("%s/%s", route, args[0])
+			}
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var escrows []types.Escrow
+			if err := cdc.UnmarshalJSON(bz, &escrows); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(escrows)
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +41,32 @@ func GetCmdSend(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetCmdMultiSend(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "multi-send [to-did-or-address]=[amount][;[to-did-or-address]=[amount]] [sender-dap-did-full]",
+		Short: "Create and sign a send tx to many recipients using DIDs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputsStr := args[0]
+			fromDxp := args[1]
+
+			outputs, err := types.ParseMultiSendOutputs(outputsStr)
+			if err != nil {
+				return err
+			}
+
+			dxpDID, err := did.UnmarshalIxoDid(fromDxp)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithFromAddress(dxpDID.Address())
+			msg := types.NewMsgMultiSend(outputs, dxpDID.Did)
+			return ante.NewDidTxBuild(cliCtx, msg, dxpDID).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
 func GetCmdOracleTransfer(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "oracle-transfer [from-did] [to-did-or-addr] [amount] [oracle-dap-did] [proof]",
@@ -137,3 +166,162 @@ func GetCmdOracleBurn(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdSetOperationEnabled(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-operation-enabled [send|mint|burn] [true|false] [admin-dap-did] [denom]",
+		Short: "Enable or disable an operation globally, or for a denom, as the emergency admin",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operation := args[0]
+			enabledStr := args[1]
+			ixoDidStr := args[2]
+			var denom string
+			if len(args) == 4 {
+				denom = args[3]
+			}
+
+			enabled, err := strconv.ParseBool(enabledStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSetOperationEnabled(operation, denom, enabled, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdSetBlacklisted(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-blacklisted [did-or-addr] [true|false] [oracle-dap-did]",
+		Short: "Add a DID or address to the blacklist, or remove it, as a compliance oracle",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			didOrAddr := args[0]
+			blacklistedStr := args[1]
+			ixoDidStr := args[2]
+
+			blacklisted, err := strconv.ParseBool(blacklistedStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgSetBlacklisted(didOrAddr, blacklisted, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdCreateEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-escrow [recipient-did-or-addr] [amount] [release-did] [timeout] [sender-dap-did]",
+		Short: "Put tokens in escrow until the release DID releases them, or until an RFC3339 timeout",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipientDidOrAddr := args[0]
+			coinsStr := args[1]
+			releaseDid := args[2]
+			timeoutStr := args[3]
+			ixoDidStr := args[4]
+
+			coins, err := sdk.ParseCoins(coinsStr)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := time.Parse(time.RFC3339, timeoutStr)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgCreateEscrow(recipientDidOrAddr, coins, releaseDid, timeout, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdReleaseEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "release-escrow [escrow-id] [release-dap-did]",
+		Short: "Pay an escrow to its recipient as its release DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			escrowIdStr := args[0]
+			ixoDidStr := args[1]
+
+			escrowId, err := strconv.ParseUint(escrowIdStr, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgReleaseEscrow(escrowId, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
+
+func GetCmdRefundEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refund-escrow [escrow-id] [sender-dap-did]",
+		Short: "Return an escrow that timed out to its sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			escrowIdStr := args[0]
+			ixoDidStr := args[1]
+
+			escrowId, err := strconv.ParseUint(escrowIdStr, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			ixoDid, err := did.UnmarshalIxoDid(ixoDidStr)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc).
+				WithFromAddress(ixoDid.Address())
+
+			msg := types.NewMsgRefundEscrow(escrowId, ixoDid.Did)
+
+			return ante.NewDidTxBuild(cliCtx, msg, ixoDid).CompleteAndBroadcastTxCLI()
+		},
+	}
+}
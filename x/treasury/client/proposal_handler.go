@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tokenchain/dp-hub/x/treasury/client/cli"
+	"github.com/tokenchain/dp-hub/x/treasury/client/rest"
+)
+
+// ProposalHandler handles treasury blacklist proposals
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBlacklistProposal, rest.ProposalRESTHandler)
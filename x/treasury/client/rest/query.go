@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/tokenchain/dp-hub/client/utils"
+
+	"github.com/tokenchain/dp-hub/x/treasury/internal/keeper"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/treasury/params",
+		queryParamsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/treasury/blacklist",
+		queryBlacklistHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/treasury/totals",
+		queryAllOracleTotalsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/oracles/{%s}/totals", RestOracleDid),
+		queryOracleTotalsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/oracles/{%s}/history", RestOracleDid),
+		queryOracleHistoryHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/escrows/{%s}", RestEscrowId),
+		queryEscrowHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/treasury/escrows",
+		queryEscrowsHandler(cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/parties/{%s}/escrows", RestParty),
+		queryEscrowsHandler(cliCtx)).Methods("GET")
+}
+
+func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryParams)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var params types.Params
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, params)
+	}
+}
+
+func queryBlacklistHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryBlacklist)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var blacklist []sdk.AccAddress
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &blacklist); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, blacklist)
+	}
+}
+
+func queryAllOracleTotalsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s",
+			types.QuerierRoute, keeper.QueryAllOracleTotals)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var totals []types.OracleTotals
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &totals); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, totals)
+	}
+}
+
+func queryOracleTotalsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryOracleTotals, vars[RestOracleDid])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var totals types.OracleTotals
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &totals); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, totals)
+	}
+}
+
+func queryOracleHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryOracleHistory, vars[RestOracleDid])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var activities []types.OracleActivity
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &activities); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, activities)
+	}
+}
+
+func queryEscrowHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bz, _, err := utils.QueryWithData(cliCtx, "custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryEscrow, vars[RestEscrowId])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		var escrow types.Escrow
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &escrow); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, escrow)
+	}
+}
+
+func queryEscrowsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryEscrows)
+		if party := vars[RestParty]; party != "" {
+			route = fmt.Sprintf("%s/%s", route, party)
+		}
+		bz, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var escrows []types.Escrow
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &escrows); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, escrows)
+	}
+}
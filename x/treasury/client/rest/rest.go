@@ -5,6 +5,13 @@ import (
 	"github.com/gorilla/mux"
 )
 
+const (
+	RestOracleDid = "oracle_did"
+	RestEscrowId  = "escrow_id"
+	RestParty     = "party"
+)
+
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerTxRoutes(cliCtx, r)
+	registerQueryRoutes(cliCtx, r)
 }
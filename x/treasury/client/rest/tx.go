@@ -8,6 +8,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/tokenchain/dp-hub/x/dap"
 	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
@@ -23,9 +25,15 @@ func writeHead(w http.ResponseWriter, code int, txt string) {
 }
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/treasury/send", sendRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/multiSend", multiSendRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/treasury/oracleTransfer", oracleTransferRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/treasury/oracleMint", oracleMintRequestHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/treasury/oracleBurn", oracleBurnRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/setOperationEnabled", setOperationEnabledRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/setBlacklisted", setBlacklistedRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/createEscrow", createEscrowRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/releaseEscrow", releaseEscrowRequestHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/treasury/refundEscrow", refundEscrowRequestHandler(cliCtx)).Methods("POST")
 }
 
 func sendRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -64,6 +72,41 @@ func sendRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func multiSendRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		outputsParam := r.URL.Query().Get("outputs")
+		sovrinDidParam := r.URL.Query().Get("ixoDid")
+
+		mode := r.URL.Query().Get("mode")
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		outputs, err := types.ParseMultiSendOutputs(outputsParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		sovrinDid, err := exported.UnmarshalDxpDid(sovrinDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgMultiSend(outputs, sovrinDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, sovrinDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
 func oracleTransferRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -174,4 +217,191 @@ func oracleBurnRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		rest.PostProcessResponse(w, cliCtx, output)
 	}
-}
\ No newline at end of file
+}
+
+func setOperationEnabledRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		operationParam := r.URL.Query().Get("operation")
+		denomParam := r.URL.Query().Get("denom")
+		enabledParam := r.URL.Query().Get("enabled")
+		adminDidParam := r.URL.Query().Get("adminDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		enabled, err := strconv.ParseBool(enabledParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		adminDid, err := exported.UnmarshalDxpDid(adminDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetOperationEnabled(operationParam, denomParam, enabled, adminDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, adminDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func setBlacklistedRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		didOrAddrParam := r.URL.Query().Get("didOrAddr")
+		blacklistedParam := r.URL.Query().Get("blacklisted")
+		oracleDidParam := r.URL.Query().Get("oracleDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		blacklisted, err := strconv.ParseBool(blacklistedParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		oracleDid, err := exported.UnmarshalDxpDid(oracleDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetBlacklisted(didOrAddrParam, blacklisted, oracleDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, oracleDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func createEscrowRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		recipientParam := r.URL.Query().Get("recipientDidOrAddr")
+		amountParam := r.URL.Query().Get("amount")
+		releaseDidParam := r.URL.Query().Get("releaseDid")
+		timeoutParam := r.URL.Query().Get("timeout")
+		senderDidParam := r.URL.Query().Get("senderDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		coins, err := sdk.ParseCoins(amountParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		timeout, err := time.Parse(time.RFC3339, timeoutParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		senderDid, err := exported.UnmarshalDxpDid(senderDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateEscrow(recipientParam, coins, releaseDidParam, timeout, senderDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, senderDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func releaseEscrowRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		escrowIdParam := r.URL.Query().Get("escrowId")
+		releaseDidParam := r.URL.Query().Get("releaseDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		escrowId, err := strconv.ParseUint(escrowIdParam, 10, 64)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		releaseDid, err := exported.UnmarshalDxpDid(releaseDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgReleaseEscrow(escrowId, releaseDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, releaseDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
+
+func refundEscrowRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		escrowIdParam := r.URL.Query().Get("escrowId")
+		senderDidParam := r.URL.Query().Get("senderDid")
+		mode := r.URL.Query().Get("mode")
+
+		cliCtx = cliCtx.WithBroadcastMode(mode)
+
+		escrowId, err := strconv.ParseUint(escrowIdParam, 10, 64)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		senderDid, err := exported.UnmarshalDxpDid(senderDidParam)
+		if err != nil {
+			writeHead(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRefundEscrow(escrowId, senderDid.Did)
+
+		output, err := dap.SignAndBroadcastTxRest(cliCtx, msg, senderDid)
+		if err != nil {
+			writeHead(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, output)
+	}
+}
@@ -0,0 +1,48 @@
+package treasury
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new treasury genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// Init params
+	keeper.SetParams(ctx, data.Params)
+
+	// Init blacklist
+	for _, address := range data.Blacklist {
+		keeper.SetBlacklisted(ctx, address, true)
+	}
+
+	// Init spent proofs
+	for _, spentProof := range data.SpentProofs {
+		keeper.SetProofSpent(ctx, spentProof)
+	}
+
+	// Init oracle totals and histories
+	for _, totals := range data.OracleTotals {
+		keeper.SetOracleTotals(ctx, totals)
+	}
+	for _, activity := range data.OracleActivities {
+		keeper.SetOracleActivity(ctx, activity)
+	}
+	keeper.SetNextActivityId(ctx, data.NextActivityId)
+
+	// Init oracle usage, which counts towards the window limits
+	for _, usage := range data.OracleUsage {
+		keeper.SetOracleUsage(ctx, usage)
+	}
+
+	// Init escrows, whose funds are held by the escrow module account
+	for _, escrow := range data.Escrows {
+		keeper.SetEscrow(ctx, escrow)
+	}
+	keeper.SetNextEscrowId(ctx, data.NextEscrowId)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetBlacklist(ctx), keeper.GetSpentProofs(ctx),
+		keeper.GetAllOracleTotals(ctx), keeper.GetOracleActivities(ctx, ""), keeper.GetNextActivityId(ctx),
+		keeper.GetAllOracleUsage(ctx), keeper.GetEscrows(ctx, ""), keeper.GetNextEscrowId(ctx))
+}
@@ -1,7 +1,11 @@
 package treasury
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 	"github.com/tokenchain/dp-hub/x/treasury/internal/keeper"
 	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
@@ -13,21 +17,35 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSend:
 			return handleMsgSend(ctx, k, msg)
+		case MsgMultiSend:
+			return handleMsgMultiSend(ctx, k, msg)
 		case MsgOracleTransfer:
 			return handleMsgOracleTransfer(ctx, k, msg)
 		case MsgOracleMint:
 			return handleMsgOracleMint(ctx, k, msg)
 		case MsgOracleBurn:
 			return handleMsgOracleBurn(ctx, k, msg)
+		case MsgSetOperationEnabled:
+			return handleMsgSetOperationEnabled(ctx, k, msg)
+		case MsgSetBlacklisted:
+			return handleMsgSetBlacklisted(ctx, k, msg)
+		case MsgCreateEscrow:
+			return handleMsgCreateEscrow(ctx, k, msg)
+		case MsgReleaseEscrow:
+			return handleMsgReleaseEscrow(ctx, k, msg)
+		case MsgRefundEscrow:
+			return handleMsgRefundEscrow(ctx, k, msg)
 		default:
 			return nil, exported.UnknownRequest("No match for message type.")
 		}
 	}
-	// TODO: be able to disable sends/mints/burns globally
-	// TODO: be able to blacklist addresses/DIDs
 }
 
 func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationSend, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	if err := k.Send(ctx, msg.FromDid, msg.ToDidOrAddr, msg.Amount); err != nil {
 		return &sdk.Result{}, err
 	}
@@ -43,9 +61,44 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) (*sdk.Re
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationSend, msg.Total()); err != nil {
+		return nil, err
+	}
+
+	addresses, err := k.MultiSend(ctx, msg.FromDid, msg.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, o := range msg.Outputs {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMultiSendOutput,
+				sdk.NewAttribute(types.AttributeKeyFromDid, msg.FromDid),
+				sdk.NewAttribute(types.AttributeKeyRecipient, o.ToDidOrAddr),
+				sdk.NewAttribute(types.AttributeKeyAddress, addresses[i].String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, o.Amount.String()),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgOracleTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleTransfer) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationSend, msg.Amount); err != nil {
+		return nil, err
+	}
 
-	if err := k.OracleTransfer(ctx, msg.FromDid, msg.ToDidOrAddr, msg.OracleDid, msg.Amount); err != nil {
+	if err := k.OracleTransfer(ctx, msg.FromDid, msg.ToDidOrAddr, msg.OracleDid, msg.Amount, msg.Proof); err != nil {
 		return nil, err
 	}
 
@@ -61,8 +114,11 @@ func handleMsgOracleTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgOrac
 }
 
 func handleMsgOracleMint(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleMint) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationMint, msg.Amount); err != nil {
+		return nil, err
+	}
 
-	if err := k.OracleMint(ctx, msg.OracleDid, msg.ToDidOrAddr, msg.Amount); err != nil {
+	if err := k.OracleMint(ctx, msg.OracleDid, msg.ToDidOrAddr, msg.Amount, msg.Proof); err != nil {
 		return nil, err
 	}
 
@@ -77,8 +133,11 @@ func handleMsgOracleMint(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleMi
 }
 
 func handleMsgOracleBurn(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleBurn) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationBurn, msg.Amount); err != nil {
+		return nil, err
+	}
 
-	if err := k.OracleBurn(ctx, msg.OracleDid, msg.FromDid, msg.Amount); err != nil {
+	if err := k.OracleBurn(ctx, msg.OracleDid, msg.FromDid, msg.Amount, msg.Proof); err != nil {
 		return nil, err
 	}
 
@@ -92,3 +151,154 @@ func handleMsgOracleBurn(ctx sdk.Context, k keeper.Keeper, msg types.MsgOracleBu
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetOperationEnabled(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetOperationEnabled) (*sdk.Result, error) {
+	if err := k.SetOperationEnabled(ctx, msg.AdminDid, msg.Operation, msg.Denom, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetOperationEnabled,
+			sdk.NewAttribute(types.AttributeKeyAdminDid, msg.AdminDid),
+			sdk.NewAttribute(types.AttributeKeyOperation, msg.Operation),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBlacklisted(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetBlacklisted) (*sdk.Result, error) {
+	address, err := k.OracleSetBlacklisted(ctx, msg.OracleDid, msg.DidOrAddr, msg.Blacklisted)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetBlacklisted,
+			sdk.NewAttribute(types.AttributeKeyOracleDid, msg.OracleDid),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyBlacklisted, strconv.FormatBool(msg.Blacklisted)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateEscrow) (*sdk.Result, error) {
+	if err := k.CheckEnabled(ctx, types.OperationSend, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	escrow, err := k.CreateEscrow(ctx, msg.SenderDid, msg.RecipientDidOrAddr, msg.ReleaseDid, msg.Amount, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowId, strconv.FormatUint(escrow.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFromDid, escrow.SenderDid),
+			sdk.NewAttribute(types.AttributeKeyRecipient, escrow.RecipientDidOrAddr),
+			sdk.NewAttribute(types.AttributeKeyReleaseDid, escrow.ReleaseDid),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeout, escrow.Timeout.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgReleaseEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgReleaseEscrow) (*sdk.Result, error) {
+	escrow, err := k.GetEscrow(ctx, msg.EscrowId)
+	if err != nil {
+		return nil, err
+	} else if err := k.CheckEnabled(ctx, types.OperationSend, escrow.Amount); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.ReleaseEscrow(ctx, msg.ReleaseDid, msg.EscrowId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReleaseEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowId, strconv.FormatUint(escrow.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReleaseDid, escrow.ReleaseDid),
+			sdk.NewAttribute(types.AttributeKeyRecipient, escrow.RecipientDidOrAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRefundEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgRefundEscrow) (*sdk.Result, error) {
+	escrow, err := k.RefundEscrow(ctx, msg.SenderDid, msg.EscrowId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowId, strconv.FormatUint(escrow.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyFromDid, escrow.SenderDid),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func NewBlacklistProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.BlacklistProposal:
+			return handleBlacklistProposal(ctx, k, c)
+		default:
+			return exported.UnknownRequest(fmt.Sprintf("unrecognized treasury proposal content type: %T", c))
+		}
+	}
+}
+
+func handleBlacklistProposal(ctx sdk.Context, k keeper.Keeper, p types.BlacklistProposal) error {
+	address, err := k.SetBlacklistedDidOrAddr(ctx, p.DidOrAddr, p.Blacklisted)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBlacklisted,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			sdk.NewAttribute(types.AttributeKeyBlacklisted, strconv.FormatBool(p.Blacklisted)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// GetOracleTotals returns the cumulative amounts that an oracle minted and
+// burned
+func (k Keeper) GetOracleTotals(ctx sdk.Context, oracleDid exported.Did) types.OracleTotals {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOracleTotalsKey(oracleDid))
+	if bz == nil {
+		return types.NewOracleTotals(oracleDid, nil, nil)
+	}
+
+	var totals types.OracleTotals
+	k.cdc.MustUnmarshalBinaryBare(bz, &totals)
+	return totals
+}
+
+func (k Keeper) SetOracleTotals(ctx sdk.Context, totals types.OracleTotals) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOracleTotalsKey(totals.OracleDid), k.cdc.MustMarshalBinaryBare(totals))
+}
+
+// GetAllOracleTotals returns the totals of every oracle that minted or burned
+func (k Keeper) GetAllOracleTotals(ctx sdk.Context) (totals []types.OracleTotals) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleTotalsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.OracleTotals
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &t)
+		totals = append(totals, t)
+	}
+
+	return totals
+}
+
+// GetNextActivityId returns the id of the next oracle activity
+func (k Keeper) GetNextActivityId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextActivityIdKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextActivityId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextActivityIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetOracleActivity stores an activity, counting it in the history of its
+// oracle if it is new
+func (k Keeper) SetOracleActivity(ctx sdk.Context, activity types.OracleActivity) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleActivityKey(activity.OracleDid, activity.Id)
+	if !store.Has(key) {
+		k.setOracleActivityCount(ctx, activity.OracleDid, k.getOracleActivityCount(ctx, activity.OracleDid)+1)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(activity))
+}
+
+// getOracleActivityCount returns the number of activities in the history of an
+// oracle
+func (k Keeper) getOracleActivityCount(ctx sdk.Context, oracleDid exported.Did) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOracleActivityCountKey(oracleDid))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setOracleActivityCount(ctx sdk.Context, oracleDid exported.Did, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetOracleActivityCountKey(oracleDid), sdk.Uint64ToBigEndian(count))
+}
+
+// GetOracleActivities returns the history of an oracle, oldest first, or the
+// histories of every oracle one after another if the oracle DID is empty
+func (k Keeper) GetOracleActivities(ctx sdk.Context, oracleDid exported.Did) (activities []types.OracleActivity) {
+	prefix := types.OracleActivityKeyPrefix
+	if oracleDid != "" {
+		prefix = types.GetOracleActivityKeyPrefix(oracleDid)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var activity types.OracleActivity
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &activity)
+		activities = append(activities, activity)
+	}
+
+	return activities
+}
+
+// pruneOracleActivities deletes the oldest activities of an oracle so that at
+// most OracleHistoryLength are kept. Only the deleted activities are read.
+func (k Keeper) pruneOracleActivities(ctx sdk.Context, oracleDid exported.Did) {
+	count := k.getOracleActivityCount(ctx, oracleDid)
+	historyLength := k.GetParams(ctx).OracleHistoryLength
+	if count <= historyLength {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOracleActivityKeyPrefix(oracleDid))
+
+	var stale [][]byte
+	for ; iterator.Valid() && uint64(len(stale)) < count-historyLength; iterator.Next() {
+		stale = append(stale, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+	k.setOracleActivityCount(ctx, oracleDid, count-uint64(len(stale)))
+}
+
+// recordOracleActivity adds an operation to the history of its oracle, pruning
+// the oldest, and adds a mint or a burn to the oracle's totals
+func (k Keeper) recordOracleActivity(ctx sdk.Context, activity types.OracleActivity) {
+	activity.Id = k.GetNextActivityId(ctx)
+	k.SetOracleActivity(ctx, activity)
+	k.SetNextActivityId(ctx, activity.Id+1)
+	k.pruneOracleActivities(ctx, activity.OracleDid)
+
+	totals := k.GetOracleTotals(ctx, activity.OracleDid)
+	switch activity.Operation {
+	case types.OperationMint:
+		totals.Minted = totals.Minted.Add(activity.Amount...)
+	case types.OperationBurn:
+		totals.Burned = totals.Burned.Add(activity.Amount...)
+	default:
+		return
+	}
+	k.SetOracleTotals(ctx, totals)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// IsBlacklisted checks if an address is blacklisted
+func (k Keeper) IsBlacklisted(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetBlacklistKey(address))
+}
+
+// SetBlacklisted adds an address to the blacklist or removes it
+func (k Keeper) SetBlacklisted(ctx sdk.Context, address sdk.AccAddress, blacklisted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if blacklisted {
+		store.Set(types.GetBlacklistKey(address), []byte{0x01})
+	} else {
+		store.Delete(types.GetBlacklistKey(address))
+	}
+}
+
+// GetBlacklist returns the blacklisted addresses
+func (k Keeper) GetBlacklist(ctx sdk.Context) (blacklist []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlacklistKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[len(types.BlacklistKeyPrefix):])
+		blacklist = append(blacklist, address)
+	}
+
+	return blacklist
+}
+
+// CheckNotBlacklisted returns an error if any of the addresses is blacklisted
+func (k Keeper) CheckNotBlacklisted(ctx sdk.Context, addresses ...sdk.AccAddress) error {
+	for _, address := range addresses {
+		if k.IsBlacklisted(ctx, address) {
+			return types.ErrAddressBlacklisted(address)
+		}
+	}
+	return nil
+}
+
+// SetBlacklistedDidOrAddr adds the address of a DID, or a bech32 address, to
+// the blacklist or removes it, and returns the address
+func (k Keeper) SetBlacklistedDidOrAddr(ctx sdk.Context, didOrAddr string, blacklisted bool) (sdk.AccAddress, error) {
+	address, err := k.StringToDx0Addr(ctx, didOrAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetBlacklisted(ctx, address, blacklisted)
+	return address, nil
+}
+
+// OracleSetBlacklisted is SetBlacklistedDidOrAddr on behalf of an oracle with
+// the compliance capability
+func (k Keeper) OracleSetBlacklisted(ctx sdk.Context, oracleDid exported.Did, didOrAddr string, blacklisted bool) (sdk.AccAddress, error) {
+	// Check if oracle exists and has bonded the min bond
+	if !k.oraclesKeeper.OracleExists(ctx, oracleDid) {
+		return nil, exported.IntErr("oracle specified is not a registered oracle")
+	} else if err := k.oraclesKeeper.CheckBonded(ctx, oracleDid); err != nil {
+		return nil, err
+	}
+
+	// Confirm that oracle has the compliance capability
+	oracle := k.oraclesKeeper.MustGetOracle(ctx, oracleDid)
+	if !oracle.Capabilities.IncludesCap(oracles.ComplianceCap) {
+		return nil, exported.Unauthorized("oracle does not have the compliance capability")
+	}
+
+	return k.SetBlacklistedDidOrAddr(ctx, didOrAddr, blacklisted)
+}
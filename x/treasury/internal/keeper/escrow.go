@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// GetNextEscrowId returns the id of the next escrow
+func (k Keeper) GetNextEscrowId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextEscrowIdKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextEscrowId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextEscrowIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetEscrow returns an open escrow
+func (k Keeper) GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEscrowKey(id))
+	if bz == nil {
+		return types.Escrow{}, types.ErrEscrowNotFound(id)
+	}
+
+	var escrow types.Escrow
+	k.cdc.MustUnmarshalBinaryBare(bz, &escrow)
+	return escrow, nil
+}
+
+// SetEscrow stores an escrow and indexes it under each of its parties
+func (k Keeper) SetEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEscrowKey(escrow.Id), k.cdc.MustMarshalBinaryBare(escrow))
+	for _, party := range escrow.Parties() {
+		store.Set(types.GetEscrowPartyKey(party, escrow.Id), []byte{0x01})
+	}
+}
+
+func (k Keeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEscrowKey(escrow.Id))
+	for _, party := range escrow.Parties() {
+		store.Delete(types.GetEscrowPartyKey(party, escrow.Id))
+	}
+}
+
+// GetEscrows returns the open escrows that a DID or address is a party of, or
+// every open escrow if the party is empty
+func (k Keeper) GetEscrows(ctx sdk.Context, party string) (escrows []types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+
+	if party == "" {
+		iterator := sdk.KVStorePrefixIterator(store, types.EscrowKeyPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var escrow types.Escrow
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &escrow)
+			escrows = append(escrows, escrow)
+		}
+		return escrows
+	}
+
+	prefix := types.GetEscrowPartyKeyPrefix(party)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+		escrow, err := k.GetEscrow(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+		escrows = append(escrows, escrow)
+	}
+	return escrows
+}
+
+// CreateEscrow moves the amount from the sender into escrow, to be released to
+// the recipient by the release DID before the timeout
+func (k Keeper) CreateEscrow(ctx sdk.Context, senderDid exported.Did, recipientDidOrAddr string,
+	releaseDid exported.Did, amount sdk.Coins, timeout time.Time) (types.Escrow, error) {
+	if !ctx.BlockTime().Before(timeout) {
+		return types.Escrow{}, types.ErrInvalidEscrow("timeout must be in the future")
+	}
+
+	senderAddress, _, err := k.resolveParties(ctx, senderDid, recipientDidOrAddr)
+	if err != nil {
+		return types.Escrow{}, err
+	} else if _, err := k.didKeeper.GetDidDoc(ctx, releaseDid); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx,
+		senderAddress, types.EscrowAccountName, amount); err != nil {
+		return types.Escrow{}, err
+	}
+
+	id := k.GetNextEscrowId(ctx)
+	escrow := types.NewEscrow(id, senderDid, recipientDidOrAddr, releaseDid, amount, timeout)
+	k.SetEscrow(ctx, escrow)
+	k.SetNextEscrowId(ctx, id+1)
+	return escrow, nil
+}
+
+// ReleaseEscrow pays an escrow to its recipient on behalf of its release DID
+func (k Keeper) ReleaseEscrow(ctx sdk.Context, releaseDid exported.Did, id uint64) (types.Escrow, error) {
+	escrow, err := k.GetEscrow(ctx, id)
+	if err != nil {
+		return types.Escrow{}, err
+	} else if escrow.ReleaseDid != releaseDid {
+		return types.Escrow{}, exported.Unauthorized("signer is not the release did of the escrow")
+	} else if escrow.IsTimedOut(ctx.BlockTime()) {
+		return types.Escrow{}, types.ErrInvalidEscrow(fmt.Sprintf("escrow %d timed out", id))
+	}
+
+	recipientAddress, err := k.StringToDx0Addr(ctx, escrow.RecipientDidOrAddr)
+	if err != nil {
+		return types.Escrow{}, err
+	} else if err := k.CheckNotBlacklisted(ctx, recipientAddress); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx,
+		types.EscrowAccountName, recipientAddress, escrow.Amount); err != nil {
+		return types.Escrow{}, err
+	}
+
+	k.deleteEscrow(ctx, escrow)
+	return escrow, nil
+}
+
+// RefundEscrow returns an escrow that timed out to its sender
+func (k Keeper) RefundEscrow(ctx sdk.Context, senderDid exported.Did, id uint64) (types.Escrow, error) {
+	escrow, err := k.GetEscrow(ctx, id)
+	if err != nil {
+		return types.Escrow{}, err
+	} else if escrow.SenderDid != senderDid {
+		return types.Escrow{}, exported.Unauthorized("signer is not the sender of the escrow")
+	} else if !escrow.IsTimedOut(ctx.BlockTime()) {
+		return types.Escrow{}, types.ErrInvalidEscrow(fmt.Sprintf(
+			"escrow %d cannot be refunded before %s", id, escrow.Timeout))
+	}
+
+	senderAddress, err := k.StringToDx0Addr(ctx, escrow.SenderDid)
+	if err != nil {
+		return types.Escrow{}, err
+	} else if err := k.CheckNotBlacklisted(ctx, senderAddress); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx,
+		types.EscrowAccountName, senderAddress, escrow.Amount); err != nil {
+		return types.Escrow{}, err
+	}
+
+	k.deleteEscrow(ctx, escrow)
+	return escrow, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tokenchain/dp-hub/x/did"
 	"github.com/tokenchain/dp-hub/x/did/exported"
@@ -15,31 +16,66 @@ import (
 type Keeper struct {
 	cdc           *codec.Codec
 	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
 	bankKeeper    bank.Keeper
 	oraclesKeeper oracles.Keeper
 	supplyKeeper  supply.Keeper
 	didKeeper     did.Keeper
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bankKeeper bank.Keeper,
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, bankKeeper bank.Keeper,
 	oraclesKeeper oracles.Keeper, supplyKeeper supply.Keeper, didKeeper did.Keeper) Keeper {
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:    bankKeeper,
 		oraclesKeeper: oraclesKeeper,
 		supplyKeeper:  supplyKeeper,
 		didKeeper:     didKeeper,
 	}
 }
-func (k Keeper) Send(ctx sdk.Context, fromDid, toDidOrAddr string, amount sdk.Coins) error {
-	fromDidDoc, err := k.didKeeper.GetDidDoc(ctx, fromDid)
-	if err != nil {
-		fmt.Println("error occurred: ", err)
+
+// GetParams returns the total set of treasury parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of treasury parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CheckEnabled returns an error if the operation is disabled for any of the
+// denoms of the amount
+func (k Keeper) CheckEnabled(ctx sdk.Context, operation string, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, c := range amount {
+		if !params.IsEnabled(operation, c.Denom) {
+			return types.ErrOperationDisabled(operation, c.Denom)
+		}
+	}
+	return nil
+}
+
+// SetOperationEnabled enables or disables an operation on behalf of the
+// emergency admin, globally or for a denom if the denom is not empty
+func (k Keeper) SetOperationEnabled(ctx sdk.Context, adminDid exported.Did, operation, denom string, enabled bool) error {
+	params := k.GetParams(ctx)
+	if params.EmergencyAdminDid == "" || params.EmergencyAdminDid != adminDid {
+		return exported.Unauthorized("signer is not the treasury emergency admin")
+	} else if err := types.ValidateOperation(operation); err != nil {
 		return err
 	}
-	fromAddress := fromDidDoc.Address()
-	toAddress, err := k.StringToDx0Addr(ctx, toDidOrAddr)
+
+	params.SetEnabled(operation, denom, enabled)
+	k.SetParams(ctx, params)
+	return nil
+}
+
+func (k Keeper) Send(ctx sdk.Context, fromDid, toDidOrAddr string, amount sdk.Coins) error {
+	fromAddress, toAddress, err := k.resolveParties(ctx, fromDid, toDidOrAddr)
 	if err != nil {
 		return err
 	}
@@ -48,10 +84,71 @@ func (k Keeper) Send(ctx sdk.Context, fromDid, toDidOrAddr string, amount sdk.Co
 	}
 	return nil
 }
-func (k Keeper) OracleTransfer(ctx sdk.Context, fromDid exported.Did, toDidOrAddr string, oracleDid exported.Did, amount sdk.Coins) error {
-	// Check if oracle exists
+
+// MultiSend sends from a DID to many recipients at once, and returns the
+// addresses of the recipients in the order of the outputs
+func (k Keeper) MultiSend(ctx sdk.Context, fromDid exported.Did, outputs []types.MultiSendOutput) ([]sdk.AccAddress, error) {
+	if maxOutputs := k.GetParams(ctx).MaxMultiSendOutputs; uint64(len(outputs)) > maxOutputs {
+		return nil, types.ErrTooManyOutputs(len(outputs), maxOutputs)
+	}
+
+	fromAddress, err := k.StringToDx0Addr(ctx, fromDid)
+	if err != nil {
+		return nil, err
+	} else if err := k.CheckNotBlacklisted(ctx, fromAddress); err != nil {
+		return nil, err
+	}
+
+	var total sdk.Coins
+	addresses := make([]sdk.AccAddress, len(outputs))
+	bankOutputs := make([]bank.Output, len(outputs))
+	for i, o := range outputs {
+		addresses[i], err = k.StringToDx0Addr(ctx, o.ToDidOrAddr)
+		if err != nil {
+			return nil, err
+		} else if err := k.CheckNotBlacklisted(ctx, addresses[i]); err != nil {
+			return nil, err
+		}
+		bankOutputs[i] = bank.NewOutput(addresses[i], o.Amount)
+		total = total.Add(o.Amount...)
+	}
+
+	inputs := []bank.Input{bank.NewInput(fromAddress, total)}
+	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, bankOutputs); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// resolveParties resolves the sender and the recipient of a send to their
+// addresses, so that a blacklisted party is caught whether it is given as a
+// DID or as an address
+func (k Keeper) resolveParties(ctx sdk.Context, fromDid, toDidOrAddr string) (sdk.AccAddress, sdk.AccAddress, error) {
+	fromAddress, err := k.StringToDx0Addr(ctx, fromDid)
+	if err != nil {
+		return nil, nil, err
+	}
+	toAddress, err := k.StringToDx0Addr(ctx, toDidOrAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.CheckNotBlacklisted(ctx, fromAddress, toAddress); err != nil {
+		return nil, nil, err
+	}
+	return fromAddress, toAddress, nil
+}
+
+func (k Keeper) OracleTransfer(ctx sdk.Context, fromDid exported.Did, toDidOrAddr string, oracleDid exported.Did, amount sdk.Coins, proof string) error {
+	fromAddress, toAddress, err := k.resolveParties(ctx, fromDid, toDidOrAddr)
+	if err != nil {
+		return err
+	}
+
+	// Check if oracle exists and has bonded the min bond
 	if !k.oraclesKeeper.OracleExists(ctx, oracleDid) {
 		return exported.IntErr("oracle specified is not a registered oracle")
+	} else if err := k.oraclesKeeper.CheckBonded(ctx, oracleDid); err != nil {
+		return err
 	}
 
 	// Confirm that oracle has the required capabilities
@@ -70,19 +167,34 @@ func (k Keeper) OracleTransfer(ctx sdk.Context, fromDid exported.Did, toDidOrAdd
 		}
 	}
 
+	// Verify and spend the proof
+	if err := k.VerifyOracleProof(ctx, oracle, types.OperationSend, amount, fromDid, toDidOrAddr, proof); err != nil {
+		return err
+	}
+
 	// Perform send
-	return k.Send(ctx, fromDid, toDidOrAddr, amount)
+	if err := k.bankKeeper.SendCoins(ctx, fromAddress, toAddress, amount); err != nil {
+		return err
+	}
+
+	k.recordOracleActivity(ctx, types.NewOracleActivity(oracleDid, types.OperationSend,
+		fromDid, toDidOrAddr, amount, proof, ctx.BlockHeight()))
+	return nil
 }
-func (k Keeper) OracleMint(ctx sdk.Context, oracleDid exported.Did, toDidOrAddr string, amount sdk.Coins) error {
+func (k Keeper) OracleMint(ctx sdk.Context, oracleDid exported.Did, toDidOrAddr string, amount sdk.Coins, proof string) error {
 
 	toAddress, err := k.StringToDx0Addr(ctx, toDidOrAddr)
 	if err != nil {
 		return err
+	} else if err := k.CheckNotBlacklisted(ctx, toAddress); err != nil {
+		return err
 	}
 
-	// Check if oracle exists
+	// Check if oracle exists and has bonded the min bond
 	if !k.oraclesKeeper.OracleExists(ctx, oracleDid) {
 		return exported.IntErr("oracle specified is not a registered oracle")
+	} else if err := k.oraclesKeeper.CheckBonded(ctx, oracleDid); err != nil {
+		return err
 	}
 
 	// Confirm that oracle has the required capabilities
@@ -101,6 +213,16 @@ func (k Keeper) OracleMint(ctx sdk.Context, oracleDid exported.Did, toDidOrAddr
 		}
 	}
 
+	// Check that the oracle stays within its limits
+	if err := k.CheckOracleLimits(ctx, oracle, oracles.MintCap, amount); err != nil {
+		return err
+	}
+
+	// Verify and spend the proof
+	if err := k.VerifyOracleProof(ctx, oracle, types.OperationMint, amount, "", toDidOrAddr, proof); err != nil {
+		return err
+	}
+
 	// Mint coins to module account
 	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, amount); err != nil {
 		return err
@@ -110,9 +232,13 @@ func (k Keeper) OracleMint(ctx sdk.Context, oracleDid exported.Did, toDidOrAddr
 	if err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, amount); err != nil {
 		return err
 	}
+
+	k.recordOracleUsage(ctx, oracle, oracles.MintCap, amount)
+	k.recordOracleActivity(ctx, types.NewOracleActivity(oracleDid, types.OperationMint,
+		"", toDidOrAddr, amount, proof, ctx.BlockHeight()))
 	return nil
 }
-func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid exported.Did, amount sdk.Coins) error {
+func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid exported.Did, amount sdk.Coins, proof string) error {
 	// Get from address
 	fromDidDoc, err := k.didKeeper.GetDidDoc(ctx, fromDid)
 	if err != nil {
@@ -120,9 +246,11 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid exported.Did, amo
 	}
 	fromAddress := fromDidDoc.Address()
 
-	// Check if oracle exists
+	// Check if oracle exists and has bonded the min bond
 	if !k.oraclesKeeper.OracleExists(ctx, oracleDid) {
 		return exported.IntErr("oracle specified is not a registered oracle")
+	} else if err := k.oraclesKeeper.CheckBonded(ctx, oracleDid); err != nil {
+		return err
 	}
 
 	// Confirm that oracle has the required capabilities
@@ -141,6 +269,16 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid exported.Did, amo
 		}
 	}
 
+	// Check that the oracle stays within its limits
+	if err := k.CheckOracleLimits(ctx, oracle, oracles.BurnCap, amount); err != nil {
+		return err
+	}
+
+	// Verify and spend the proof
+	if err := k.VerifyOracleProof(ctx, oracle, types.OperationBurn, amount, "", fromDid, proof); err != nil {
+		return err
+	}
+
 	// Take tokens to burn from account
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx,
 		fromAddress, types.ModuleName, amount); err != nil {
@@ -152,6 +290,9 @@ func (k Keeper) OracleBurn(ctx sdk.Context, oracleDid, fromDid exported.Did, amo
 		return err
 	}
 
+	k.recordOracleUsage(ctx, oracle, oracles.BurnCap, amount)
+	k.recordOracleActivity(ctx, types.NewOracleActivity(oracleDid, types.OperationBurn,
+		fromDid, "", amount, proof, ctx.BlockHeight()))
 	return nil
 }
 func (k Keeper) StringToDx0Addr(ctx sdk.Context, unknown_address_string string) (sdk.AccAddress, error) {
@@ -0,0 +1,331 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tokenchain/dp-hub/x/oracles"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+func TestCheckEnabled(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	require.Nil(t, k.CheckEnabled(ctx, types.OperationMint, validAmount))
+
+	// only the emergency admin can switch operations
+	require.NotNil(t, k.SetOperationEnabled(ctx, officer.Did, types.OperationMint, "dap", false))
+	params := k.GetParams(ctx)
+	params.EmergencyAdminDid = officer.Did
+	k.SetParams(ctx, params)
+	require.NotNil(t, k.SetOperationEnabled(ctx, sender.Did, types.OperationMint, "dap", false))
+
+	require.Nil(t, k.SetOperationEnabled(ctx, officer.Did, types.OperationMint, "dap", false))
+	require.NotNil(t, k.CheckEnabled(ctx, types.OperationMint, validAmount))
+	require.Nil(t, k.CheckEnabled(ctx, types.OperationSend, validAmount))
+	require.Nil(t, k.CheckEnabled(ctx, types.OperationMint, sdk.NewCoins(sdk.NewInt64Coin("res", 1))))
+}
+
+func TestBlacklist(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+	require.Nil(t, k.Send(ctx, sender.Did, recipient.Did, validAmount))
+
+	// a blacklisted recipient is caught by its did and by its address
+	address, err := k.SetBlacklistedDidOrAddr(ctx, recipient.Did, true)
+	require.Nil(t, err)
+	require.Equal(t, recipient.Address(), address)
+	require.NotNil(t, k.Send(ctx, sender.Did, recipient.Did, validAmount))
+	require.NotNil(t, k.Send(ctx, sender.Did, recipient.Address().String(), validAmount))
+	require.NotNil(t, k.OracleTransfer(ctx, sender.Did, recipient.Address().String(), oracle.Did, validAmount, "proof"))
+	require.NotNil(t, k.OracleMint(ctx, oracle.Did, recipient.Address().String(), validAmount, "proof"))
+
+	// a blacklisted sender cannot send
+	require.NotNil(t, k.Send(ctx, recipient.Did, sender.Did, validAmount))
+	require.NotNil(t, k.OracleTransfer(ctx, recipient.Did, sender.Did, oracle.Did, validAmount, "proof"))
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, recipient.Address()))
+
+	require.Equal(t, []sdk.AccAddress{recipient.Address()}, k.GetBlacklist(ctx))
+	_, err = k.SetBlacklistedDidOrAddr(ctx, recipient.Address().String(), false)
+	require.Nil(t, err)
+	require.Nil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, validAmount, "proof"))
+	require.Empty(t, k.GetBlacklist(ctx))
+}
+
+func TestOracleSetBlacklisted(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	// only an oracle with the compliance capability manages the blacklist
+	_, err := k.OracleSetBlacklisted(ctx, oracle.Did, sender.Did, true)
+	require.NotNil(t, err)
+	_, err = k.OracleSetBlacklisted(ctx, sender.Did, sender.Did, true)
+	require.NotNil(t, err)
+	require.False(t, k.IsBlacklisted(ctx, sender.Address()))
+
+	_, err = k.OracleSetBlacklisted(ctx, officer.Did, sender.Did, true)
+	require.Nil(t, err)
+	require.True(t, k.IsBlacklisted(ctx, sender.Address()))
+	require.NotNil(t, k.CheckNotBlacklisted(ctx, recipient.Address(), sender.Address()))
+}
+
+func TestOraclesNeedMinBond(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	params := oracles.DefaultParams()
+	params.BondDenom = "dap"
+	params.MinBond = sdk.NewInt(50)
+	k.oraclesKeeper.SetParams(ctx, params)
+
+	// registered oracles below the min bond cannot operate
+	require.NotNil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, oracle.Did, validAmount, "proof"))
+	require.NotNil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, validAmount, "proof"))
+	mintProof := signProof(types.OperationMint, validAmount, recipient.Did, "mint:1")
+	require.NotNil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, mintProof))
+	burnProof := signProof(types.OperationBurn, validAmount, recipient.Did, "burn:1")
+	require.NotNil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+	_, err := k.OracleSetBlacklisted(ctx, officer.Did, sender.Did, true)
+	require.NotNil(t, err)
+	require.Empty(t, k.GetOracleActivities(ctx, ""))
+
+	// once bonded they can
+	for _, id := range []string{oracle.Did, provenOracle.Did, officer.Did} {
+		require.Nil(t, k.oraclesKeeper.Bond(ctx, id, sdk.NewInt64Coin("dap", 50)))
+	}
+	require.Nil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, oracle.Did, validAmount, "proof"))
+	require.Nil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, validAmount, "proof"))
+	require.Nil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, mintProof))
+	require.Nil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+	_, err = k.OracleSetBlacklisted(ctx, officer.Did, sender.Did, true)
+	require.Nil(t, err)
+}
+
+func signProof(operation string, amount sdk.Coins, account, externalTxId string) string {
+	return signTransferProof(operation, amount, "", account, externalTxId)
+}
+
+func signTransferProof(operation string, amount sdk.Coins, from, account, externalTxId string) string {
+	signature, err := attester.SignMessage(types.OracleProofSignBytes(operation, amount, from, account, externalTxId))
+	if err != nil {
+		panic(err)
+	}
+	return externalTxId + ":" + base58.Encode(signature)
+}
+
+func TestVerifyOracleProof(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+
+	// the proof must be an attestation over the amount and the recipient
+	proof := signProof(types.OperationMint, validAmount, recipient.Did, "0xabc:1")
+	require.NotNil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, "proof"))
+	require.NotNil(t, k.OracleMint(ctx, provenOracle.Did, sender.Did, validAmount, proof))
+	require.NotNil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, initialCoins, proof))
+	require.NotNil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, provenOracle.Did, validAmount, proof))
+	require.False(t, k.IsProofSpent(ctx, provenOracle.Did, "0xabc:1"))
+
+	require.Nil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, proof))
+	require.True(t, k.IsProofSpent(ctx, provenOracle.Did, "0xabc:1"))
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, recipient.Address()))
+
+	// a spent proof cannot be replayed, even for another operation
+	require.NotNil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, proof))
+	burnProof := signProof(types.OperationBurn, validAmount, recipient.Did, "0xabc:1")
+	require.NotNil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+
+	burnProof = signProof(types.OperationBurn, validAmount, recipient.Did, "0xabc:2")
+	require.Nil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+	require.Equal(t, initialCoins, bk.GetCoins(ctx, recipient.Address()))
+
+	require.Equal(t, []types.SpentProof{
+		types.NewSpentProof(provenOracle.Did, "0xabc:1"),
+		types.NewSpentProof(provenOracle.Did, "0xabc:2"),
+	}, k.GetSpentProofs(ctx))
+}
+
+func TestVerifyOracleTransferProof(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+
+	// a transfer proof attests to the source, so that it cannot move the
+	// coins of another account to the recipient
+	proof := signTransferProof(types.OperationSend, validAmount, sender.Did, recipient.Did, "0xdef:1")
+	require.NotNil(t, k.OracleTransfer(ctx, officer.Did, recipient.Did, provenOracle.Did, validAmount, proof))
+	require.NotNil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, provenOracle.Did, validAmount,
+		signProof(types.OperationSend, validAmount, recipient.Did, "0xdef:1")))
+	require.False(t, k.IsProofSpent(ctx, provenOracle.Did, "0xdef:1"))
+	require.Equal(t, initialCoins, bk.GetCoins(ctx, officer.Address()))
+
+	require.Nil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, provenOracle.Did, validAmount, proof))
+	require.True(t, k.IsProofSpent(ctx, provenOracle.Did, "0xdef:1"))
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, recipient.Address()))
+}
+
+func TestOracleActivity(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	ctx = ctx.WithBlockHeight(5)
+
+	mintProof := signProof(types.OperationMint, initialCoins, recipient.Did, "mint:1")
+	require.Nil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, initialCoins, mintProof))
+	burnProof := signProof(types.OperationBurn, validAmount, recipient.Did, "burn:1")
+	require.Nil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+	require.Nil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, oracle.Did, validAmount, "proof"))
+
+	// a failed operation is not recorded
+	require.NotNil(t, k.OracleBurn(ctx, provenOracle.Did, recipient.Did, validAmount, burnProof))
+
+	// transfers are in the history but not in the totals
+	require.Equal(t, types.NewOracleTotals(provenOracle.Did, initialCoins, validAmount),
+		k.GetOracleTotals(ctx, provenOracle.Did))
+	require.Equal(t, []types.OracleTotals{k.GetOracleTotals(ctx, provenOracle.Did)}, k.GetAllOracleTotals(ctx))
+	require.True(t, k.GetOracleTotals(ctx, oracle.Did).Minted.IsZero())
+
+	mint := types.NewOracleActivity(provenOracle.Did, types.OperationMint, "", recipient.Did, initialCoins, mintProof, 5)
+	burn := types.NewOracleActivity(provenOracle.Did, types.OperationBurn, recipient.Did, "", validAmount, burnProof, 5)
+	burn.Id = 1
+	require.Equal(t, []types.OracleActivity{mint, burn}, k.GetOracleActivities(ctx, provenOracle.Did))
+	require.Len(t, k.GetOracleActivities(ctx, oracle.Did), 1)
+	require.Len(t, k.GetOracleActivities(ctx, ""), 3)
+	require.Equal(t, uint64(3), k.GetNextActivityId(ctx))
+}
+
+func TestOracleActivityPruned(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	params := k.GetParams(ctx)
+	params.OracleHistoryLength = 2
+	k.SetParams(ctx, params)
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, oracle.Did, validAmount, "proof"))
+	}
+	mintProof := signProof(types.OperationMint, validAmount, recipient.Did, "mint:1")
+	require.Nil(t, k.OracleMint(ctx, provenOracle.Did, recipient.Did, validAmount, mintProof))
+
+	// only the newest activities of each oracle are kept
+	activities := k.GetOracleActivities(ctx, oracle.Did)
+	require.Len(t, activities, 2)
+	require.Equal(t, uint64(1), activities[0].Id)
+	require.Equal(t, uint64(2), activities[1].Id)
+	require.Len(t, k.GetOracleActivities(ctx, provenOracle.Did), 1)
+	require.Equal(t, uint64(4), k.GetNextActivityId(ctx))
+	require.Equal(t, uint64(2), k.getOracleActivityCount(ctx, oracle.Did))
+
+	// the totals are not pruned
+	require.Equal(t, validAmount, k.GetOracleTotals(ctx, provenOracle.Did).Minted)
+
+	// a shorter history is pruned by the next activity
+	params.OracleHistoryLength = 1
+	k.SetParams(ctx, params)
+	require.Nil(t, k.OracleTransfer(ctx, sender.Did, recipient.Did, oracle.Did, validAmount, "proof"))
+	activities = k.GetOracleActivities(ctx, oracle.Did)
+	require.Len(t, activities, 1)
+	require.Equal(t, uint64(4), activities[0].Id)
+	require.Equal(t, uint64(1), k.getOracleActivityCount(ctx, oracle.Did))
+}
+
+func TestOracleLimits(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	ctx = ctx.WithBlockHeight(1)
+
+	// the oracle can mint up to 20dap per tx and 30dap per 10 blocks
+	limited := k.oraclesKeeper.MustGetOracle(ctx, oracle.Did)
+	limit := oracles.NewTokenLimit(sdk.NewInt(20), sdk.NewInt(30), 10)
+	limited.Capabilities[0].MintLimit = &limit
+	k.oraclesKeeper.SetOracle(ctx, limited)
+
+	require.NotNil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, sdk.NewCoins(sdk.NewInt64Coin("dap", 21)), "proof"))
+	require.Nil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, sdk.NewCoins(sdk.NewInt64Coin("dap", 20)), "proof"))
+	require.Nil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, validAmount, "proof"))
+	require.Equal(t, sdk.NewInt(30), k.GetOracleUsage(ctx, oracle.Did, types.OperationMint, "dap", 10))
+
+	// the window rolls, so what was minted at block 1 is freed at block 11
+	ctx = ctx.WithBlockHeight(10)
+	require.NotNil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, sdk.NewCoins(sdk.NewInt64Coin("dap", 1)), "proof"))
+	ctx = ctx.WithBlockHeight(11)
+	require.Nil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, sdk.NewCoins(sdk.NewInt64Coin("dap", 20)), "proof"))
+	require.NotNil(t, k.OracleMint(ctx, oracle.Did, recipient.Did, sdk.NewCoins(sdk.NewInt64Coin("dap", 11)), "proof"))
+	require.Equal(t, sdk.NewInt(20), k.GetOracleUsage(ctx, oracle.Did, types.OperationMint, "dap", 10))
+
+	// the usage that was not pruned is exported and can be imported
+	usage := []types.OracleUsage{types.NewOracleUsage(oracle.Did, types.OperationMint, "dap", 11, sdk.NewInt(20))}
+	require.Equal(t, usage, k.GetAllOracleUsage(ctx))
+	ctx, k, _ = CreateTestInput()
+	ctx = ctx.WithBlockHeight(11)
+	k.SetOracleUsage(ctx, usage[0])
+	require.Equal(t, sdk.NewInt(20), k.GetOracleUsage(ctx, oracle.Did, types.OperationMint, "dap", 10))
+}
+
+func TestMultiSend(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+	outputs := []types.MultiSendOutput{
+		{ToDidOrAddr: recipient.Did, Amount: validAmount},
+		{ToDidOrAddr: officer.Address().String(), Amount: validAmount},
+	}
+
+	addresses, err := k.MultiSend(ctx, sender.Did, outputs)
+	require.Nil(t, err)
+	require.Equal(t, []sdk.AccAddress{recipient.Address(), officer.Address()}, addresses)
+	require.Equal(t, initialCoins.Sub(validAmount).Sub(validAmount), bk.GetCoins(ctx, sender.Address()))
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, officer.Address()))
+
+	// a blacklisted recipient fails the whole send
+	k.SetBlacklisted(ctx, officer.Address(), true)
+	_, err = k.MultiSend(ctx, sender.Did, outputs)
+	require.NotNil(t, err)
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, recipient.Address()))
+
+	// the number of outputs is capped
+	params := k.GetParams(ctx)
+	params.MaxMultiSendOutputs = 1
+	k.SetParams(ctx, params)
+	_, err = k.MultiSend(ctx, sender.Did, outputs[:1])
+	require.Nil(t, err)
+	_, err = k.MultiSend(ctx, sender.Did, []types.MultiSendOutput{outputs[0], outputs[0]})
+	require.NotNil(t, err)
+}
+
+func TestEscrow(t *testing.T) {
+	ctx, k, bk := CreateTestInput()
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	timeout := now.Add(time.Hour)
+
+	// the timeout must be in the future and the sender must cover the amount
+	_, err := k.CreateEscrow(ctx, sender.Did, recipient.Did, officer.Did, validAmount, now)
+	require.NotNil(t, err)
+	_, err = k.CreateEscrow(ctx, sender.Did, recipient.Did, officer.Did, initialCoins.Add(validAmount...), timeout)
+	require.NotNil(t, err)
+
+	released, err := k.CreateEscrow(ctx, sender.Did, recipient.Did, officer.Did, validAmount, timeout)
+	require.Nil(t, err)
+	refunded, err := k.CreateEscrow(ctx, sender.Did, recipient.Address().String(), officer.Did, validAmount, timeout)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), refunded.Id)
+	require.Equal(t, initialCoins.Sub(validAmount).Sub(validAmount), bk.GetCoins(ctx, sender.Address()))
+
+	// the escrows are found by each of their parties
+	require.Len(t, k.GetEscrows(ctx, sender.Did), 2)
+	require.Len(t, k.GetEscrows(ctx, officer.Did), 2)
+	require.Equal(t, []types.Escrow{released}, k.GetEscrows(ctx, recipient.Did))
+	require.Empty(t, k.GetEscrows(ctx, oracle.Did))
+
+	// only the release did can release, and only before the timeout
+	_, err = k.ReleaseEscrow(ctx, sender.Did, released.Id)
+	require.NotNil(t, err)
+	_, err = k.ReleaseEscrow(ctx, officer.Did, released.Id)
+	require.Nil(t, err)
+	require.Equal(t, initialCoins.Add(validAmount...), bk.GetCoins(ctx, recipient.Address()))
+	_, err = k.GetEscrow(ctx, released.Id)
+	require.NotNil(t, err)
+	require.Empty(t, k.GetEscrows(ctx, recipient.Did))
+
+	// only the sender can refund, and only after the timeout
+	_, err = k.RefundEscrow(ctx, sender.Did, refunded.Id)
+	require.NotNil(t, err)
+	ctx = ctx.WithBlockTime(timeout)
+	_, err = k.ReleaseEscrow(ctx, officer.Did, refunded.Id)
+	require.NotNil(t, err)
+	_, err = k.RefundEscrow(ctx, officer.Did, refunded.Id)
+	require.NotNil(t, err)
+	_, err = k.RefundEscrow(ctx, sender.Did, refunded.Id)
+	require.Nil(t, err)
+	require.Equal(t, initialCoins.Sub(validAmount), bk.GetCoins(ctx, sender.Address()))
+	require.Empty(t, k.GetEscrows(ctx, ""))
+}
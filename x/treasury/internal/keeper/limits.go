@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// GetOracleUsage returns the amount of a denom that an oracle minted or burned
+// in the window of the last windowBlocks blocks, including the current block.
+// Usage from before the window is pruned, so the window rolls lazily.
+func (k Keeper) GetOracleUsage(ctx sdk.Context, oracleDid exported.Did, operation, denom string, windowBlocks int64) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetOracleUsageKeyPrefix(oracleDid, operation, denom)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	used := sdk.ZeroInt()
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))
+		if height <= ctx.BlockHeight()-windowBlocks {
+			expired = append(expired, iterator.Key())
+			continue
+		}
+
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		used = used.Add(amount)
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+	return used
+}
+
+// addOracleUsage adds an amount to the usage of the oracle in the current block
+func (k Keeper) addOracleUsage(ctx sdk.Context, oracleDid exported.Did, operation, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleUsageKey(oracleDid, operation, denom, ctx.BlockHeight())
+
+	if bz := store.Get(key); bz != nil {
+		var used sdk.Int
+		k.cdc.MustUnmarshalBinaryBare(bz, &used)
+		amount = amount.Add(used)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(amount))
+}
+
+func (k Keeper) SetOracleUsage(ctx sdk.Context, usage types.OracleUsage) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleUsageKey(usage.OracleDid, usage.Operation, usage.Denom, usage.Height)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(usage.Amount))
+}
+
+// GetAllOracleUsage returns the usage of every oracle that was not pruned yet
+func (k Keeper) GetAllOracleUsage(ctx sdk.Context) (usage []types.OracleUsage) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OracleUsageKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix, "<did>/<operation>/<denom>/" and the height
+		key := iterator.Key()[len(types.OracleUsageKeyPrefix):]
+		path := strings.Split(string(key[:len(key)-8]), "/")
+		height := int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		usage = append(usage, types.NewOracleUsage(path[0], path[1], path[2], height, amount))
+	}
+
+	return usage
+}
+
+// CheckOracleLimits returns an error if minting or burning the amount would
+// exceed the oracle's limits on any of its denoms
+func (k Keeper) CheckOracleLimits(ctx sdk.Context, oracle oracles.Oracle, cap oracles.TokenCap, amount sdk.Coins) error {
+	for _, c := range amount {
+		limit := oracle.Capabilities.MustGet(c.Denom).Limit(cap)
+		if limit == nil {
+			continue
+		}
+
+		if limit.MaxPerTx.IsPositive() && c.Amount.GT(limit.MaxPerTx) {
+			return types.ErrOracleLimitExceeded(oracle.OracleDid, string(cap), fmt.Sprintf(
+				"%s is more than the max of %s%s per tx", c, limit.MaxPerTx, c.Denom))
+		}
+
+		if limit.MaxPerWindow.IsPositive() {
+			used := k.GetOracleUsage(ctx, oracle.OracleDid, string(cap), c.Denom, limit.WindowBlocks)
+			if used.Add(c.Amount).GT(limit.MaxPerWindow) {
+				return types.ErrOracleLimitExceeded(oracle.OracleDid, string(cap), fmt.Sprintf(
+					"%s would be more than the max of %s%s per %d blocks, of which %s%s is used",
+					c, limit.MaxPerWindow, c.Denom, limit.WindowBlocks, used, c.Denom))
+			}
+		}
+	}
+	return nil
+}
+
+// recordOracleUsage adds the amount of every denom with a window limit to the
+// oracle's usage
+func (k Keeper) recordOracleUsage(ctx sdk.Context, oracle oracles.Oracle, cap oracles.TokenCap, amount sdk.Coins) {
+	for _, c := range amount {
+		limit := oracle.Capabilities.MustGet(c.Denom).Limit(cap)
+		if limit != nil && limit.MaxPerWindow.IsPositive() {
+			k.addOracleUsage(ctx, oracle.OracleDid, string(cap), c.Denom, c.Amount)
+		}
+	}
+}
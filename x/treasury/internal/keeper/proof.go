@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+// IsProofSpent checks if an oracle already used a proof with the external tx id
+func (k Keeper) IsProofSpent(ctx sdk.Context, oracleDid exported.Did, externalTxId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetSpentProofKey(oracleDid, externalTxId))
+}
+
+// SetProofSpent adds a proof to the spent-proof index
+func (k Keeper) SetProofSpent(ctx sdk.Context, spentProof types.SpentProof) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSpentProofKey(spentProof.OracleDid, spentProof.ExternalTxId),
+		k.cdc.MustMarshalBinaryBare(spentProof))
+}
+
+// GetSpentProofs returns the spent-proof index
+func (k Keeper) GetSpentProofs(ctx sdk.Context) (spentProofs []types.SpentProof) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SpentProofKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var spentProof types.SpentProof
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &spentProof)
+		spentProofs = append(spentProofs, spentProof)
+	}
+
+	return spentProofs
+}
+
+// VerifyOracleProof checks the proof of an oracle msg against the proof scheme
+// declared by the oracle, and spends it so that it cannot be replayed. The
+// proofs of an oracle without a proof scheme are not checked. The accounts are
+// as in OracleProofSignBytes.
+func (k Keeper) VerifyOracleProof(ctx sdk.Context, oracle oracles.Oracle, operation string,
+	amount sdk.Coins, from, account, proof string) error {
+	switch oracle.ProofScheme {
+	case oracles.ProofSchemeNone:
+		return nil
+	case oracles.ProofSchemeEd25519:
+		// checked below
+	default:
+		return types.ErrInvalidOracleProof(fmt.Sprintf(
+			"oracle has an unknown proof scheme %s", oracle.ProofScheme))
+	}
+
+	oracleProof, err := types.ParseOracleProof(proof)
+	if err != nil {
+		return err
+	} else if k.IsProofSpent(ctx, oracle.OracleDid, oracleProof.ExternalTxId) {
+		return types.ErrOracleProofSpent(oracle.OracleDid, oracleProof.ExternalTxId)
+	}
+
+	var pubKey ed25519.PubKeyEd25519
+	copy(pubKey[:], base58.Decode(oracle.ProofKey))
+	signBytes := types.OracleProofSignBytes(operation, amount, from, account, oracleProof.ExternalTxId)
+	if !pubKey.VerifyBytes(signBytes, oracleProof.Signature) {
+		return types.ErrInvalidOracleProof("signature verification failed")
+	}
+
+	k.SetProofSpent(ctx, types.NewSpentProof(oracle.OracleDid, oracleProof.ExternalTxId))
+	return nil
+}
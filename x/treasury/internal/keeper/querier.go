@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+const (
+	QueryParams          = "queryParams"
+	QueryBlacklist       = "queryBlacklist"
+	QueryOracleTotals    = "queryOracleTotals"
+	QueryAllOracleTotals = "queryAllOracleTotals"
+	QueryOracleHistory   = "queryOracleHistory"
+	QueryEscrow          = "queryEscrow"
+	QueryEscrows         = "queryEscrows"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryBlacklist:
+			return queryBlacklist(ctx, k)
+		case QueryOracleTotals:
+			return queryOracleTotals(ctx, path[1:], k)
+		case QueryAllOracleTotals:
+			return queryAllOracleTotals(ctx, k)
+		case QueryOracleHistory:
+			return queryOracleHistory(ctx, path[1:], k)
+		case QueryEscrow:
+			return queryEscrow(ctx, path[1:], k)
+		case QueryEscrows:
+			return queryEscrows(ctx, path[1:], k)
+		default:
+			return nil, exported.UnknownRequest("unknown treasury query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBlacklist(ctx sdk.Context, k Keeper) ([]byte, error) {
+	blacklist := k.GetBlacklist(ctx)
+	if blacklist == nil {
+		blacklist = []sdk.AccAddress{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, blacklist)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOracleTotals(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 || !exported.IsValidDid(path[0]) {
+		return nil, exported.ErrInvalidDid("oracle did is missing or invalid")
+	}
+
+	totals := k.GetOracleTotals(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(k.cdc, totals)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAllOracleTotals(ctx sdk.Context, k Keeper) ([]byte, error) {
+	totals := k.GetAllOracleTotals(ctx)
+	if totals == nil {
+		totals = []types.OracleTotals{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, totals)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOracleHistory(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 || !exported.IsValidDid(path[0]) {
+		return nil, exported.ErrInvalidDid("oracle did is missing or invalid")
+	}
+
+	activities := k.GetOracleActivities(ctx, path[0])
+	if activities == nil {
+		activities = []types.OracleActivity{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, activities)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryEscrow(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, exported.UnknownRequest("escrow id is missing")
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, exported.UnknownRequest(fmt.Sprintf("invalid escrow id '%s'", path[0]))
+	}
+
+	escrow, err := k.GetEscrow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, escrow)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryEscrows(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	var party string
+	if len(path) > 0 {
+		party = path[0]
+	}
+
+	escrows := k.GetEscrows(ctx, party)
+	if escrows == nil {
+		escrows = []types.Escrow{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, escrows)
+	if err != nil {
+		return nil, exported.ErrJsonMars(err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tokenchain/dp-hub/x/did"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+	"github.com/tokenchain/dp-hub/x/oracles"
+	"github.com/tokenchain/dp-hub/x/treasury/internal/types"
+)
+
+var (
+	sender    = exported.NewDidGeneratorBuilder().Build()
+	recipient = exported.NewDidGeneratorBuilder().Build()
+	oracle    = exported.NewDidGeneratorBuilder().Build()
+	officer   = exported.NewDidGeneratorBuilder().Build()
+
+	// the proven oracle's proofs are attested to by the attester
+	provenOracle = exported.NewDidGeneratorBuilder().Build()
+	attester     = exported.NewDidGeneratorBuilder().Build()
+
+	initialCoins, _ = sdk.ParseCoins("100dap")
+	validAmount, _  = sdk.ParseCoins("10dap")
+)
+
+func CreateTestInput() (sdk.Context, Keeper, bank.Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	didKey := sdk.NewKVStoreKey(did.StoreKey)
+	oraclesKey := sdk.NewKVStoreKey(oracles.StoreKey)
+	keyParams := sdk.NewKVStoreKey("subspace")
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(didKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(oraclesKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+
+	_ = ms.LoadLatestVersion()
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	module.NewBasicManager(auth.AppModuleBasic{}, supply.AppModuleBasic{}).RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	exported.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	pk1 := params.NewKeeper(cdc, keyParams, tkeyParams)
+	maccPerms := map[string][]string{
+		types.ModuleName:        {supply.Minter, supply.Burner},
+		types.EscrowAccountName: nil,
+		oracles.ModuleName:      {supply.Burner},
+	}
+
+	accountKeeper := auth.NewAccountKeeper(cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+	didKeeper := did.NewKeeper(cdc, didKey, pk1.Subspace(did.DefaultParamspace))
+	oraclesKeeper := oracles.NewKeeper(cdc, oraclesKey, pk1.Subspace(oracles.DefaultParamspace), supplyKeeper, didKeeper)
+	oraclesKeeper.SetParams(ctx, oracles.DefaultParams())
+
+	keeper := NewKeeper(cdc, storeKey, pk1.Subspace(types.DefaultParamspace), bankKeeper, oraclesKeeper, supplyKeeper, didKeeper)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	// the oracle can transfer and mint dap, the officer is a compliance oracle
+	oraclesKeeper.SetOracle(ctx, oracles.Oracle{
+		OracleDid: oracle.Did,
+		Capabilities: oracles.OracleTokenCaps{
+			{Denom: "dap", Capabilities: oracles.TokenCaps{oracles.TransferCap, oracles.MintCap}},
+		},
+	})
+	oraclesKeeper.SetOracle(ctx, oracles.Oracle{
+		OracleDid: officer.Did,
+		Capabilities: oracles.OracleTokenCaps{
+			{Denom: "dap", Capabilities: oracles.TokenCaps{oracles.ComplianceCap}},
+		},
+	})
+
+	oraclesKeeper.SetOracle(ctx, oracles.NewOracleWithProofScheme(provenOracle.Did,
+		oracles.OracleTokenCaps{
+			{Denom: "dap", Capabilities: oracles.TokenCaps{oracles.TransferCap, oracles.MintCap, oracles.BurnCap}},
+		},
+		oracles.ProofSchemeEd25519, attester.VerifyKey))
+
+	// the parties have did docs and funded accounts
+	didHandler := did.NewHandler(didKeeper)
+	for _, id := range []exported.IxoDid{sender, recipient, oracle, officer, provenOracle} {
+		_, err := didHandler(ctx, did.NewMsgAddDid(id.Did, id.VerifyKey, id.KeyType))
+		if err != nil {
+			panic(err)
+		}
+		if err := bankKeeper.SetCoins(ctx, id.Address(), initialCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	return ctx, keeper, bankKeeper
+}
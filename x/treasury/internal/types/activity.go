@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// OracleTotals are the cumulative amounts that an oracle minted and burned,
+// per denom
+type OracleTotals struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Minted    sdk.Coins    `json:"minted" yaml:"minted"`
+	Burned    sdk.Coins    `json:"burned" yaml:"burned"`
+}
+
+func NewOracleTotals(oracleDid exported.Did, minted, burned sdk.Coins) OracleTotals {
+	return OracleTotals{
+		OracleDid: oracleDid,
+		Minted:    minted,
+		Burned:    burned,
+	}
+}
+
+func (t OracleTotals) String() string {
+	return fmt.Sprintf(`Oracle Totals:
+  Oracle Did: %s
+  Minted:     %s
+  Burned:     %s
+`, t.OracleDid, t.Minted, t.Burned)
+}
+
+// OracleActivity is a transfer, mint or burn performed by an oracle. The
+// parties are as given in the msg; a mint has no sender and a burn has no
+// recipient.
+type OracleActivity struct {
+	Id        uint64       `json:"id" yaml:"id"`
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Operation string       `json:"operation" yaml:"operation"`
+	From      string       `json:"from,omitempty" yaml:"from,omitempty"`
+	To        string       `json:"to,omitempty" yaml:"to,omitempty"`
+	Amount    sdk.Coins    `json:"amount" yaml:"amount"`
+	Proof     string       `json:"proof" yaml:"proof"`
+	Height    int64        `json:"height" yaml:"height"`
+}
+
+func NewOracleActivity(oracleDid exported.Did, operation, from, to string,
+	amount sdk.Coins, proof string, height int64) OracleActivity {
+	return OracleActivity{
+		OracleDid: oracleDid,
+		Operation: operation,
+		From:      from,
+		To:        to,
+		Amount:    amount,
+		Proof:     proof,
+		Height:    height,
+	}
+}
+
+// OracleUsage is the amount of a denom that an oracle minted or burned in a
+// block, which counts towards the window limit of the oracle
+type OracleUsage struct {
+	OracleDid exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	Operation string       `json:"operation" yaml:"operation"`
+	Denom     string       `json:"denom" yaml:"denom"`
+	Height    int64        `json:"height" yaml:"height"`
+	Amount    sdk.Int      `json:"amount" yaml:"amount"`
+}
+
+func NewOracleUsage(oracleDid exported.Did, operation, denom string, height int64, amount sdk.Int) OracleUsage {
+	return OracleUsage{
+		OracleDid: oracleDid,
+		Operation: operation,
+		Denom:     denom,
+		Height:    height,
+		Amount:    amount,
+	}
+}
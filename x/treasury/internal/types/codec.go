@@ -6,9 +6,15 @@ import (
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "treasury/MsgSend", nil)
+	cdc.RegisterConcrete(MsgMultiSend{}, "treasury/MsgMultiSend", nil)
 	cdc.RegisterConcrete(MsgOracleTransfer{}, "treasury/MsgOracleTransfer", nil)
 	cdc.RegisterConcrete(MsgOracleMint{}, "treasury/MsgOracleMint", nil)
 	cdc.RegisterConcrete(MsgOracleBurn{}, "treasury/MsgOracleBurn", nil)
+	cdc.RegisterConcrete(MsgSetOperationEnabled{}, "treasury/MsgSetOperationEnabled", nil)
+	cdc.RegisterConcrete(MsgSetBlacklisted{}, "treasury/MsgSetBlacklisted", nil)
+	cdc.RegisterConcrete(MsgCreateEscrow{}, "treasury/MsgCreateEscrow", nil)
+	cdc.RegisterConcrete(MsgReleaseEscrow{}, "treasury/MsgReleaseEscrow", nil)
+	cdc.RegisterConcrete(MsgRefundEscrow{}, "treasury/MsgRefundEscrow", nil)
 }
 
 // ModuleCdc is the codec for the module
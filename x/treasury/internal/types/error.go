@@ -1,9 +1,44 @@
 package types
 
 import (
+	"fmt"
+
+	"github.com/pkg/errors"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 )
 
 const (
 	DefaultCodespace exported.CodespaceType = ModuleName
 )
+
+func ErrOperationDisabled(operation, denom string) error {
+	return errors.Wrap(exported.EOperationDisabled, fmt.Sprintf("%s of %s is disabled", operation, denom))
+}
+
+func ErrAddressBlacklisted(address fmt.Stringer) error {
+	return errors.Wrap(exported.EAddressBlacklisted, address.String())
+}
+
+func ErrInvalidOracleProof(reason string) error {
+	return errors.Wrap(exported.EInvalidOracleProof, reason)
+}
+
+func ErrOracleProofSpent(oracleDid exported.Did, externalTxId string) error {
+	return errors.Wrap(exported.EOracleProofSpent, fmt.Sprintf("%s by %s", externalTxId, oracleDid))
+}
+
+func ErrOracleLimitExceeded(oracleDid exported.Did, operation, reason string) error {
+	return errors.Wrap(exported.EOracleLimitExceeded, fmt.Sprintf("%s by %s: %s", operation, oracleDid, reason))
+}
+
+func ErrTooManyOutputs(outputs int, maxOutputs uint64) error {
+	return errors.Wrap(exported.ETooManyOutputs, fmt.Sprintf("%d outputs is more than the max of %d", outputs, maxOutputs))
+}
+
+func ErrInvalidEscrow(reason string) error {
+	return errors.Wrap(exported.EInvalidEscrow, reason)
+}
+
+func ErrEscrowNotFound(id uint64) error {
+	return errors.Wrap(exported.EEscrowNotFound, fmt.Sprintf("escrow %d", id))
+}
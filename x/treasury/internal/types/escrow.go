@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// EscrowAccountName is the module account that holds the funds in escrow
+const EscrowAccountName = "treasury_escrow"
+
+// Escrow holds funds of a sender until the release DID releases them to the
+// recipient, or until the timeout after which the sender can have them
+// refunded
+type Escrow struct {
+	Id                 uint64       `json:"id" yaml:"id"`
+	SenderDid          exported.Did `json:"sender_did" yaml:"sender_did"`
+	RecipientDidOrAddr string       `json:"recipient_did_or_addr" yaml:"recipient_did_or_addr"`
+	ReleaseDid         exported.Did `json:"release_did" yaml:"release_did"`
+	Amount             sdk.Coins    `json:"amount" yaml:"amount"`
+	Timeout            time.Time    `json:"timeout" yaml:"timeout"`
+}
+
+func NewEscrow(id uint64, senderDid exported.Did, recipientDidOrAddr string,
+	releaseDid exported.Did, amount sdk.Coins, timeout time.Time) Escrow {
+	return Escrow{
+		Id:                 id,
+		SenderDid:          senderDid,
+		RecipientDidOrAddr: recipientDidOrAddr,
+		ReleaseDid:         releaseDid,
+		Amount:             amount,
+		Timeout:            timeout,
+	}
+}
+
+// Parties returns the parties of the escrow without duplicates
+func (e Escrow) Parties() []string {
+	parties := []string{e.SenderDid}
+	for _, p := range []string{e.RecipientDidOrAddr, e.ReleaseDid} {
+		if p != parties[0] && (len(parties) == 1 || p != parties[1]) {
+			parties = append(parties, p)
+		}
+	}
+	return parties
+}
+
+// IsTimedOut checks if the escrow can no longer be released and can be
+// refunded
+func (e Escrow) IsTimedOut(blockTime time.Time) bool {
+	return !blockTime.Before(e.Timeout)
+}
+
+func (e Escrow) String() string {
+	return fmt.Sprintf(`Escrow %d:
+  Sender Did:    %s
+  Recipient:     %s
+  Release Did:   %s
+  Amount:        %s
+  Timeout:       %s
+`, e.Id, e.SenderDid, e.RecipientDidOrAddr, e.ReleaseDid, e.Amount, e.Timeout)
+}
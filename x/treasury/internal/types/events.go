@@ -1,5 +1,26 @@
 package types
 
 const (
+	EventTypeSetOperationEnabled = "set_operation_enabled"
+	EventTypeSetBlacklisted      = "set_blacklisted"
+	EventTypeMultiSendOutput     = "multi_send_output"
+	EventTypeCreateEscrow        = "create_escrow"
+	EventTypeReleaseEscrow       = "release_escrow"
+	EventTypeRefundEscrow        = "refund_escrow"
+
+	AttributeKeyAdminDid    = "admin_did"
+	AttributeKeyOperation   = "operation"
+	AttributeKeyDenom       = "denom"
+	AttributeKeyEnabled     = "enabled"
+	AttributeKeyOracleDid   = "oracle_did"
+	AttributeKeyAddress     = "address"
+	AttributeKeyBlacklisted = "blacklisted"
+	AttributeKeyFromDid     = "from_did"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyEscrowId    = "escrow_id"
+	AttributeKeyReleaseDid  = "release_did"
+	AttributeKeyTimeout     = "timeout"
+
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+type GenesisState struct {
+	Params           Params           `json:"params" yaml:"params"`
+	Blacklist        []sdk.AccAddress `json:"blacklist" yaml:"blacklist"`
+	SpentProofs      []SpentProof     `json:"spent_proofs" yaml:"spent_proofs"`
+	OracleTotals     []OracleTotals   `json:"oracle_totals" yaml:"oracle_totals"`
+	OracleActivities []OracleActivity `json:"oracle_activities" yaml:"oracle_activities"`
+	NextActivityId   uint64           `json:"next_activity_id" yaml:"next_activity_id"`
+	OracleUsage      []OracleUsage    `json:"oracle_usage" yaml:"oracle_usage"`
+	Escrows          []Escrow         `json:"escrows" yaml:"escrows"`
+	NextEscrowId     uint64           `json:"next_escrow_id" yaml:"next_escrow_id"`
+}
+
+func NewGenesisState(params Params, blacklist []sdk.AccAddress, spentProofs []SpentProof,
+	oracleTotals []OracleTotals, oracleActivities []OracleActivity, nextActivityId uint64,
+	oracleUsage []OracleUsage, escrows []Escrow, nextEscrowId uint64) GenesisState {
+	return GenesisState{
+		Params:           params,
+		Blacklist:        blacklist,
+		SpentProofs:      spentProofs,
+		OracleTotals:     oracleTotals,
+		OracleActivities: oracleActivities,
+		NextActivityId:   nextActivityId,
+		OracleUsage:      oracleUsage,
+		Escrows:          escrows,
+		NextEscrowId:     nextEscrowId,
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	// Validate params
+	err := ValidateParams(data.Params)
+	if err != nil {
+		return err
+	}
+
+	// Validate blacklist
+	blacklisted := make(map[string]bool, len(data.Blacklist))
+	for _, address := range data.Blacklist {
+		if address.Empty() {
+			return fmt.Errorf("blacklist has an empty address")
+		} else if blacklisted[address.String()] {
+			return fmt.Errorf("duplicate blacklisted address %s", address)
+		}
+		blacklisted[address.String()] = true
+	}
+
+	// Validate spent proofs
+	for _, p := range data.SpentProofs {
+		if !exported.IsValidDid(p.OracleDid) {
+			return fmt.Errorf("spent proof has an invalid oracle did %s", p.OracleDid)
+		} else if len(p.ExternalTxId) == 0 {
+			return fmt.Errorf("spent proof of %s has an empty external tx id", p.OracleDid)
+		}
+	}
+
+	// Validate oracle totals
+	oracles := make(map[exported.Did]bool, len(data.OracleTotals))
+	for _, t := range data.OracleTotals {
+		if !exported.IsValidDid(t.OracleDid) {
+			return fmt.Errorf("oracle totals have an invalid oracle did %s", t.OracleDid)
+		} else if oracles[t.OracleDid] {
+			return fmt.Errorf("duplicate oracle totals of %s", t.OracleDid)
+		} else if !t.Minted.IsValid() || !t.Burned.IsValid() {
+			return fmt.Errorf("oracle totals of %s have invalid coins", t.OracleDid)
+		}
+		oracles[t.OracleDid] = true
+	}
+
+	// Validate oracle activities
+	historyLengths := make(map[exported.Did]uint64)
+	for _, a := range data.OracleActivities {
+		if !exported.IsValidDid(a.OracleDid) {
+			return fmt.Errorf("oracle activity %d has an invalid oracle did %s", a.Id, a.OracleDid)
+		} else if a.Id >= data.NextActivityId {
+			return fmt.Errorf("oracle activity id %d is not less than the next activity id", a.Id)
+		}
+		historyLengths[a.OracleDid]++
+		if historyLengths[a.OracleDid] > data.Params.OracleHistoryLength {
+			return fmt.Errorf("history of %s is longer than the oracle history length", a.OracleDid)
+		}
+	}
+
+	// Validate oracle usage
+	usage := make(map[string]bool, len(data.OracleUsage))
+	for _, u := range data.OracleUsage {
+		key := string(GetOracleUsageKey(u.OracleDid, u.Operation, u.Denom, u.Height))
+		if !exported.IsValidDid(u.OracleDid) {
+			return fmt.Errorf("oracle usage has an invalid oracle did %s", u.OracleDid)
+		} else if u.Operation != OperationMint && u.Operation != OperationBurn {
+			return fmt.Errorf("oracle usage of %s has an invalid operation %s", u.OracleDid, u.Operation)
+		} else if err := sdk.ValidateDenom(u.Denom); err != nil {
+			return fmt.Errorf("oracle usage of %s has an invalid denom: %s", u.OracleDid, err)
+		} else if u.Height < 0 {
+			return fmt.Errorf("oracle usage of %s has a negative height", u.OracleDid)
+		} else if u.Amount == (sdk.Int{}) || !u.Amount.IsPositive() {
+			return fmt.Errorf("oracle usage of %s must have a positive amount", u.OracleDid)
+		} else if usage[key] {
+			return fmt.Errorf("duplicate oracle usage of %s in %s%s at height %d",
+				u.OracleDid, u.Operation, u.Denom, u.Height)
+		}
+		usage[key] = true
+	}
+
+	// Validate escrows
+	for _, e := range data.Escrows {
+		if e.Id >= data.NextEscrowId {
+			return fmt.Errorf("escrow id %d is not less than the next escrow id", e.Id)
+		} else if !exported.IsValidDid(e.SenderDid) || !exported.IsValidDid(e.ReleaseDid) {
+			return fmt.Errorf("escrow %d has an invalid sender or release did", e.Id)
+		} else if err := ValidateDidOrAddr(e.RecipientDidOrAddr); err != nil {
+			return err
+		} else if !e.Amount.IsValid() {
+			return fmt.Errorf("escrow %d has an invalid amount", e.Id)
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:           DefaultParams(),
+		Blacklist:        nil,
+		SpentProofs:      nil,
+		OracleTotals:     nil,
+		OracleActivities: nil,
+		NextActivityId:   0,
+		OracleUsage:      nil,
+		Escrows:          nil,
+		NextEscrowId:     0,
+	}
+}
@@ -1,8 +1,72 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
 const (
-	ModuleName   = "treasury"
-	StoreKey     = ModuleName
-	RouterKey    = ModuleName
-	QuerierRoute = ModuleName
+	ModuleName        = "treasury"
+	DefaultParamspace = ModuleName
+	StoreKey          = ModuleName
+	RouterKey         = ModuleName
+	QuerierRoute      = ModuleName
+)
+
+var (
+	BlacklistKeyPrefix      = []byte{0x01}
+	SpentProofKeyPrefix     = []byte{0x02}
+	OracleTotalsKeyPrefix   = []byte{0x03}
+	OracleActivityKeyPrefix = []byte{0x04}
+	NextActivityIdKey       = []byte{0x05}
+	OracleUsageKeyPrefix    = []byte{0x06}
+	EscrowKeyPrefix         = []byte{0x07}
+	EscrowPartyKeyPrefix    = []byte{0x08}
+	NextEscrowIdKey         = []byte{0x09}
+
+	OracleActivityCountKeyPrefix = []byte{0x0A}
 )
+
+func GetBlacklistKey(address sdk.AccAddress) []byte {
+	return append(BlacklistKeyPrefix, address...)
+}
+
+func GetSpentProofKey(oracleDid exported.Did, externalTxId string) []byte {
+	return append(SpentProofKeyPrefix, []byte(oracleDid+"/"+externalTxId)...)
+}
+
+func GetOracleTotalsKey(oracleDid exported.Did) []byte {
+	return append(OracleTotalsKeyPrefix, []byte(oracleDid)...)
+}
+
+func GetOracleActivityKey(oracleDid exported.Did, id uint64) []byte {
+	return append(GetOracleActivityKeyPrefix(oracleDid), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetOracleActivityKeyPrefix(oracleDid exported.Did) []byte {
+	return append(OracleActivityKeyPrefix, []byte(oracleDid+"/")...)
+}
+
+func GetOracleActivityCountKey(oracleDid exported.Did) []byte {
+	return append(OracleActivityCountKeyPrefix, []byte(oracleDid)...)
+}
+
+func GetOracleUsageKey(oracleDid exported.Did, operation, denom string, height int64) []byte {
+	return append(GetOracleUsageKeyPrefix(oracleDid, operation, denom), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetOracleUsageKeyPrefix(oracleDid exported.Did, operation, denom string) []byte {
+	return append(OracleUsageKeyPrefix, []byte(oracleDid+"/"+operation+"/"+denom+"/")...)
+}
+
+func GetEscrowKey(id uint64) []byte {
+	return append(EscrowKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetEscrowPartyKey(party string, id uint64) []byte {
+	return append(GetEscrowPartyKeyPrefix(party), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetEscrowPartyKeyPrefix(party string) []byte {
+	return append(EscrowPartyKeyPrefix, []byte(party+"/")...)
+}
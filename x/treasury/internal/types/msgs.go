@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tokenchain/dp-hub/x/did/ante"
 	"github.com/tokenchain/dp-hub/x/did/exported"
@@ -9,16 +11,32 @@ import (
 
 const (
 	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multi-send"
 	TypeMsgOracleTransfer = "oracle-transfer"
 	TypeMsgOracleMint     = "oracle-mint"
 	TypeMsgOracleBurn     = "oracle-burn"
+
+	TypeMsgSetOperationEnabled = "set-operation-enabled"
+	TypeMsgSetBlacklisted      = "set-blacklisted"
+
+	TypeMsgCreateEscrow  = "create-escrow"
+	TypeMsgReleaseEscrow = "release-escrow"
+	TypeMsgRefundEscrow  = "refund-escrow"
 )
 
 var (
 	_ ante.IxoMsg = MsgSend{}
+	_ ante.IxoMsg = MsgMultiSend{}
 	_ ante.IxoMsg = MsgOracleTransfer{}
 	_ ante.IxoMsg = MsgOracleMint{}
 	_ ante.IxoMsg = MsgOracleBurn{}
+
+	_ ante.IxoMsg = MsgSetOperationEnabled{}
+	_ ante.IxoMsg = MsgSetBlacklisted{}
+
+	_ ante.IxoMsg = MsgCreateEscrow{}
+	_ ante.IxoMsg = MsgReleaseEscrow{}
+	_ ante.IxoMsg = MsgRefundEscrow{}
 )
 
 type MsgSend struct {
@@ -76,6 +94,74 @@ func (msg MsgSend) GetSignBytes() []byte {
 	}
 }
 
+// MultiSendOutput is a recipient of a multi-send and the amount it receives
+type MultiSendOutput struct {
+	ToDidOrAddr string    `json:"to_did" yaml:"to_did"`
+	Amount      sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+type MsgMultiSend struct {
+	FromDid exported.Did      `json:"from_did" yaml:"from_did"`
+	Outputs []MultiSendOutput `json:"outputs" yaml:"outputs"`
+}
+
+func (msg MsgMultiSend) Type() string  { return TypeMsgMultiSend }
+func (msg MsgMultiSend) Route() string { return RouterKey }
+func (msg MsgMultiSend) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.FromDid, "FromDid"); !valid {
+		return err
+	} else if len(msg.Outputs) == 0 {
+		return exported.UnknownRequest("Outputs is empty.")
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.FromDid) {
+		return exported.ErrInvalidDid("from did is invalid")
+	}
+
+	// Check outputs, each of which must send a positive amount
+	for _, o := range msg.Outputs {
+		_, err := sdk.AccAddressFromBech32(o.ToDidOrAddr)
+		if err != nil && !exported.IsValidDid(o.ToDidOrAddr) {
+			return exported.InvalidAddress("recipient is neither a did nor an address: " + o.ToDidOrAddr)
+		} else if !o.Amount.IsAllPositive() {
+			return exported.ErrInvalidCoins("send amount is invalid: " + o.Amount.String())
+		}
+	}
+
+	return nil
+}
+
+// Total returns the sum of the amounts of the outputs
+func (msg MsgMultiSend) Total() (total sdk.Coins) {
+	for _, o := range msg.Outputs {
+		total = total.Add(o.Amount...)
+	}
+	return total
+}
+
+func (msg MsgMultiSend) GetSignerDid() exported.Did { return msg.FromDid }
+func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgMultiSend) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgMultiSend) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
 type MsgOracleTransfer struct {
 	OracleDid   exported.Did `json:"oracle_did" yaml:"oracle_did"`
 	FromDid     exported.Did `json:"from_did" yaml:"from_did"`
@@ -251,3 +337,255 @@ func (msg MsgOracleBurn) GetSignBytes() []byte {
 		return sdk.MustSortJSON(bz)
 	}
 }
+
+// MsgSetOperationEnabled enables or disables an operation globally, or for a
+// denom if the denom is not empty. It is signed by the emergency admin.
+type MsgSetOperationEnabled struct {
+	AdminDid  exported.Did `json:"admin_did" yaml:"admin_did"`
+	Operation string       `json:"operation" yaml:"operation"`
+	Denom     string       `json:"denom" yaml:"denom"`
+	Enabled   bool         `json:"enabled" yaml:"enabled"`
+}
+
+func (msg MsgSetOperationEnabled) Type() string  { return TypeMsgSetOperationEnabled }
+func (msg MsgSetOperationEnabled) Route() string { return RouterKey }
+func (msg MsgSetOperationEnabled) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.AdminDid, "AdminDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.AdminDid) {
+		return exported.ErrInvalidDid("admin did is invalid")
+	}
+
+	// Check operation and denom
+	if err := ValidateOperation(msg.Operation); err != nil {
+		return err
+	} else if msg.Denom != "" {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return exported.ErrInvalidCoins(err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgSetOperationEnabled) GetSignerDid() exported.Did { return msg.AdminDid }
+func (msg MsgSetOperationEnabled) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgSetOperationEnabled) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgSetOperationEnabled) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgSetBlacklisted adds the address of a DID or a bech32 address to the
+// blacklist, or removes it from the blacklist. It is signed by an oracle with
+// the compliance capability.
+type MsgSetBlacklisted struct {
+	OracleDid   exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	DidOrAddr   string       `json:"did_or_addr" yaml:"did_or_addr"`
+	Blacklisted bool         `json:"blacklisted" yaml:"blacklisted"`
+}
+
+func (msg MsgSetBlacklisted) Type() string  { return TypeMsgSetBlacklisted }
+func (msg MsgSetBlacklisted) Route() string { return RouterKey }
+func (msg MsgSetBlacklisted) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.OracleDid, "OracleDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.OracleDid) {
+		return exported.ErrInvalidDid("oracle did is invalid")
+	}
+
+	return ValidateDidOrAddr(msg.DidOrAddr)
+}
+
+func (msg MsgSetBlacklisted) GetSignerDid() exported.Did { return msg.OracleDid }
+func (msg MsgSetBlacklisted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgSetBlacklisted) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgSetBlacklisted) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgCreateEscrow moves funds of the sender into escrow until the release DID
+// releases them to the recipient, or until the timeout
+type MsgCreateEscrow struct {
+	SenderDid          exported.Did `json:"sender_did" yaml:"sender_did"`
+	RecipientDidOrAddr string       `json:"recipient_did_or_addr" yaml:"recipient_did_or_addr"`
+	ReleaseDid         exported.Did `json:"release_did" yaml:"release_did"`
+	Amount             sdk.Coins    `json:"amount" yaml:"amount"`
+	Timeout            time.Time    `json:"timeout" yaml:"timeout"`
+}
+
+func (msg MsgCreateEscrow) Type() string  { return TypeMsgCreateEscrow }
+func (msg MsgCreateEscrow) Route() string { return RouterKey }
+func (msg MsgCreateEscrow) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid"); !valid {
+		return err
+	} else if valid, err = CheckNotEmpty(msg.ReleaseDid, "ReleaseDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.SenderDid) {
+		return exported.ErrInvalidDid("sender did is invalid")
+	} else if !exported.IsValidDid(msg.ReleaseDid) {
+		return exported.ErrInvalidDid("release did is invalid")
+	} else if err := ValidateDidOrAddr(msg.RecipientDidOrAddr); err != nil {
+		return err
+	}
+
+	// Check amount (note: validity also checks that coins are positive)
+	if !msg.Amount.IsValid() {
+		return exported.ErrInvalidCoins("escrow amount is invalid: " + msg.Amount.String())
+	}
+
+	// Check timeout
+	if msg.Timeout.IsZero() {
+		return ErrInvalidEscrow("timeout is not set")
+	}
+
+	return nil
+}
+
+func (msg MsgCreateEscrow) GetSignerDid() exported.Did { return msg.SenderDid }
+func (msg MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgCreateEscrow) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgCreateEscrow) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgReleaseEscrow pays an escrow to its recipient before its timeout. It is
+// signed by the release DID of the escrow.
+type MsgReleaseEscrow struct {
+	ReleaseDid exported.Did `json:"release_did" yaml:"release_did"`
+	EscrowId   uint64       `json:"escrow_id" yaml:"escrow_id"`
+}
+
+func (msg MsgReleaseEscrow) Type() string  { return TypeMsgReleaseEscrow }
+func (msg MsgReleaseEscrow) Route() string { return RouterKey }
+func (msg MsgReleaseEscrow) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.ReleaseDid, "ReleaseDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.ReleaseDid) {
+		return exported.ErrInvalidDid("release did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgReleaseEscrow) GetSignerDid() exported.Did { return msg.ReleaseDid }
+func (msg MsgReleaseEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgReleaseEscrow) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgReleaseEscrow) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
+
+// MsgRefundEscrow returns an escrow to its sender once it timed out. It is
+// signed by the sender of the escrow.
+type MsgRefundEscrow struct {
+	SenderDid exported.Did `json:"sender_did" yaml:"sender_did"`
+	EscrowId  uint64       `json:"escrow_id" yaml:"escrow_id"`
+}
+
+func (msg MsgRefundEscrow) Type() string  { return TypeMsgRefundEscrow }
+func (msg MsgRefundEscrow) Route() string { return RouterKey }
+func (msg MsgRefundEscrow) ValidateBasic() error {
+	// Check that not empty
+	if valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid"); !valid {
+		return err
+	}
+
+	// Check that DIDs valid
+	if !exported.IsValidDid(msg.SenderDid) {
+		return exported.ErrInvalidDid("sender did is invalid")
+	}
+
+	return nil
+}
+
+func (msg MsgRefundEscrow) GetSignerDid() exported.Did { return msg.SenderDid }
+func (msg MsgRefundEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{ante.DidToAddr(msg.GetSignerDid())}
+}
+
+func (msg MsgRefundEscrow) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+func (msg MsgRefundEscrow) GetSignBytes() []byte {
+	if bz, err := json.Marshal(msg); err != nil {
+		panic(err)
+	} else {
+		return sdk.MustSortJSON(bz)
+	}
+}
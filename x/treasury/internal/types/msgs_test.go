@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+func TestMsgMultiSendValidateBasic(t *testing.T) {
+	from := exported.NewDidGeneratorBuilder().Build().Did
+	to := exported.NewDidGeneratorBuilder().Build().Did
+	amount := sdk.NewCoins(sdk.NewInt64Coin("dap", 10))
+	multiSend := func(outputs ...MultiSendOutput) MsgMultiSend {
+		return MsgMultiSend{FromDid: from, Outputs: outputs}
+	}
+
+	require.Nil(t, multiSend(MultiSendOutput{to, amount}).ValidateBasic())
+	require.NotNil(t, multiSend().ValidateBasic())
+	require.NotNil(t, multiSend(MultiSendOutput{"to", amount}).ValidateBasic())
+
+	// every output must send a positive amount
+	require.NotNil(t, multiSend(MultiSendOutput{to, amount}, MultiSendOutput{to, sdk.NewCoins()}).ValidateBasic())
+	require.NotNil(t, multiSend(MultiSendOutput{to, nil}).ValidateBasic())
+	require.NotNil(t, multiSend(MultiSendOutput{to, sdk.Coins{sdk.NewInt64Coin("dap", 0)}}).ValidateBasic())
+}
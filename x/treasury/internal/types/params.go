@@ -0,0 +1,281 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// Operations that can be disabled. An oracle transfer is a send.
+const (
+	OperationSend = "send"
+	OperationMint = "mint"
+	OperationBurn = "burn"
+)
+
+// Parameter store keys
+var (
+	KeySendEnabled         = []byte("SendEnabled")
+	KeyMintEnabled         = []byte("MintEnabled")
+	KeyBurnEnabled         = []byte("BurnEnabled")
+	KeyDenomFlags          = []byte("DenomFlags")
+	KeyEmergencyAdminDid   = []byte("EmergencyAdminDid")
+	KeyMaxMultiSendOutputs = []byte("MaxMultiSendOutputs")
+	KeyOracleHistoryLength = []byte("OracleHistoryLength")
+)
+
+// DenomFlags enable or disable the operations on a single denom. A denom
+// without flags can be used in all operations that are enabled globally.
+type DenomFlags struct {
+	Denom       string `json:"denom" yaml:"denom"`
+	SendEnabled bool   `json:"send_enabled" yaml:"send_enabled"`
+	MintEnabled bool   `json:"mint_enabled" yaml:"mint_enabled"`
+	BurnEnabled bool   `json:"burn_enabled" yaml:"burn_enabled"`
+}
+
+func NewDenomFlags(denom string, sendEnabled, mintEnabled, burnEnabled bool) DenomFlags {
+	return DenomFlags{
+		Denom:       denom,
+		SendEnabled: sendEnabled,
+		MintEnabled: mintEnabled,
+		BurnEnabled: burnEnabled,
+	}
+}
+
+func (f DenomFlags) isEnabled(operation string) bool {
+	switch operation {
+	case OperationSend:
+		return f.SendEnabled
+	case OperationMint:
+		return f.MintEnabled
+	case OperationBurn:
+		return f.BurnEnabled
+	default:
+		return false
+	}
+}
+
+func (f *DenomFlags) setEnabled(operation string, enabled bool) {
+	switch operation {
+	case OperationSend:
+		f.SendEnabled = enabled
+	case OperationMint:
+		f.MintEnabled = enabled
+	case OperationBurn:
+		f.BurnEnabled = enabled
+	}
+}
+
+// treasury parameters
+type Params struct {
+	SendEnabled         bool         `json:"send_enabled" yaml:"send_enabled"`
+	MintEnabled         bool         `json:"mint_enabled" yaml:"mint_enabled"`
+	BurnEnabled         bool         `json:"burn_enabled" yaml:"burn_enabled"`
+	DenomFlags          []DenomFlags `json:"denom_flags" yaml:"denom_flags"`
+	EmergencyAdminDid   exported.Did `json:"emergency_admin_did" yaml:"emergency_admin_did"` // may enable and disable operations without a proposal
+	MaxMultiSendOutputs uint64       `json:"max_multi_send_outputs" yaml:"max_multi_send_outputs"`
+	OracleHistoryLength uint64       `json:"oracle_history_length" yaml:"oracle_history_length"` // activities kept per oracle, the oldest are pruned
+}
+
+// ParamTable for treasury module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(sendEnabled, mintEnabled, burnEnabled bool,
+	denomFlags []DenomFlags, emergencyAdminDid exported.Did, maxMultiSendOutputs, oracleHistoryLength uint64) Params {
+	return Params{
+		SendEnabled:         sendEnabled,
+		MintEnabled:         mintEnabled,
+		BurnEnabled:         burnEnabled,
+		DenomFlags:          denomFlags,
+		EmergencyAdminDid:   emergencyAdminDid,
+		MaxMultiSendOutputs: maxMultiSendOutputs,
+		OracleHistoryLength: oracleHistoryLength,
+	}
+}
+
+// default treasury module parameters
+func DefaultParams() Params {
+	return Params{
+		SendEnabled:         true,
+		MintEnabled:         true,
+		BurnEnabled:         true,
+		DenomFlags:          nil,
+		EmergencyAdminDid:   "",
+		MaxMultiSendOutputs: 100,
+		OracleHistoryLength: 1000,
+	}
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateBool),
+		params.NewParamSetPair(KeyMintEnabled, &p.MintEnabled, validateBool),
+		params.NewParamSetPair(KeyBurnEnabled, &p.BurnEnabled, validateBool),
+		params.NewParamSetPair(KeyDenomFlags, &p.DenomFlags, validateDenomFlags),
+		params.NewParamSetPair(KeyEmergencyAdminDid, &p.EmergencyAdminDid, validateEmergencyAdminDid),
+		params.NewParamSetPair(KeyMaxMultiSendOutputs, &p.MaxMultiSendOutputs, validateMaxMultiSendOutputs),
+		params.NewParamSetPair(KeyOracleHistoryLength, &p.OracleHistoryLength, validateOracleHistoryLength),
+	}
+}
+
+// IsEnabled checks if an operation is enabled globally and for the denom
+func (p Params) IsEnabled(operation, denom string) bool {
+	if !p.isEnabledGlobally(operation) {
+		return false
+	}
+	for _, f := range p.DenomFlags {
+		if f.Denom == denom {
+			return f.isEnabled(operation)
+		}
+	}
+	return true
+}
+
+func (p Params) isEnabledGlobally(operation string) bool {
+	switch operation {
+	case OperationSend:
+		return p.SendEnabled
+	case OperationMint:
+		return p.MintEnabled
+	case OperationBurn:
+		return p.BurnEnabled
+	default:
+		return false
+	}
+}
+
+// SetEnabled enables or disables an operation globally, or for a denom if the
+// denom is not empty. A denom that gets flags keeps the other operations
+// enabled.
+func (p *Params) SetEnabled(operation, denom string, enabled bool) {
+	if denom == "" {
+		switch operation {
+		case OperationSend:
+			p.SendEnabled = enabled
+		case OperationMint:
+			p.MintEnabled = enabled
+		case OperationBurn:
+			p.BurnEnabled = enabled
+		}
+		return
+	}
+
+	for i := range p.DenomFlags {
+		if p.DenomFlags[i].Denom == denom {
+			p.DenomFlags[i].setEnabled(operation, enabled)
+			return
+		}
+	}
+	flags := NewDenomFlags(denom, true, true, true)
+	flags.setEnabled(operation, enabled)
+	p.DenomFlags = append(p.DenomFlags, flags)
+}
+
+// validate params
+func ValidateParams(params Params) error {
+	if err := validateDenomFlags(params.DenomFlags); err != nil {
+		return err
+	}
+	if err := validateEmergencyAdminDid(params.EmergencyAdminDid); err != nil {
+		return err
+	}
+	if err := validateMaxMultiSendOutputs(params.MaxMultiSendOutputs); err != nil {
+		return err
+	}
+	return validateOracleHistoryLength(params.OracleHistoryLength)
+}
+
+func (p Params) String() string {
+	var denomFlags []string
+	for _, f := range p.DenomFlags {
+		denomFlags = append(denomFlags, fmt.Sprintf("%s (send: %t, mint: %t, burn: %t)",
+			f.Denom, f.SendEnabled, f.MintEnabled, f.BurnEnabled))
+	}
+	return fmt.Sprintf(`Treasury Params:
+  Send Enabled:           %t
+  Mint Enabled:           %t
+  Burn Enabled:           %t
+  Denom Flags:            %s
+  Emergency Admin Did:    %s
+  Max Multi-Send Outputs: %d
+  Oracle History Length:  %d
+`,
+		p.SendEnabled, p.MintEnabled, p.BurnEnabled,
+		strings.Join(denomFlags, ", "), p.EmergencyAdminDid, p.MaxMultiSendOutputs,
+		p.OracleHistoryLength,
+	)
+}
+
+// ValidateOperation checks that an operation can be enabled or disabled
+func ValidateOperation(operation string) error {
+	switch operation {
+	case OperationSend, OperationMint, OperationBurn:
+		return nil
+	default:
+		return exported.UnknownRequest(fmt.Sprintf("unknown treasury operation %s", operation))
+	}
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDenomFlags(i interface{}) error {
+	denomFlags, ok := i.([]DenomFlags)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	denoms := make(map[string]bool, len(denomFlags))
+	for _, f := range denomFlags {
+		if err := sdk.ValidateDenom(f.Denom); err != nil {
+			return fmt.Errorf("treasury parameter DenomFlags has an invalid denom: %s", err)
+		} else if denoms[f.Denom] {
+			return fmt.Errorf("treasury parameter DenomFlags has duplicate denom %s", f.Denom)
+		}
+		denoms[f.Denom] = true
+	}
+	return nil
+}
+
+func validateEmergencyAdminDid(i interface{}) error {
+	adminDid, ok := i.(exported.Did)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if adminDid != "" && !exported.IsValidDid(adminDid) {
+		return fmt.Errorf("treasury parameter EmergencyAdminDid is an invalid did %s", adminDid)
+	}
+	return nil
+}
+
+func validateMaxMultiSendOutputs(i interface{}) error {
+	maxOutputs, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if maxOutputs == 0 {
+		return fmt.Errorf("treasury parameter MaxMultiSendOutputs must be positive")
+	}
+	return nil
+}
+
+func validateOracleHistoryLength(i interface{}) error {
+	historyLength, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if historyLength == 0 {
+		return fmt.Errorf("treasury parameter OracleHistoryLength must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsIsEnabled(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.IsEnabled(OperationSend, "dap"))
+	require.True(t, params.IsEnabled(OperationMint, "dap"))
+	require.True(t, params.IsEnabled(OperationBurn, "dap"))
+	require.False(t, params.IsEnabled("stake", "dap"))
+
+	// a denom that is disabled keeps its other operations enabled
+	params.SetEnabled(OperationMint, "dap", false)
+	require.False(t, params.IsEnabled(OperationMint, "dap"))
+	require.True(t, params.IsEnabled(OperationSend, "dap"))
+	require.True(t, params.IsEnabled(OperationMint, "res"))
+
+	// an operation that is disabled globally is disabled for every denom
+	params.SetEnabled(OperationSend, "", false)
+	require.False(t, params.IsEnabled(OperationSend, "dap"))
+	require.False(t, params.IsEnabled(OperationSend, "res"))
+
+	// the denom flags are kept when an operation is enabled again globally
+	params.SetEnabled(OperationSend, "", true)
+	params.SetEnabled(OperationMint, "dap", true)
+	require.True(t, params.IsEnabled(OperationMint, "dap"))
+	require.Len(t, params.DenomFlags, 1)
+	require.Nil(t, ValidateParams(params))
+}
+
+func TestValidateParams(t *testing.T) {
+	require.Nil(t, ValidateParams(DefaultParams()))
+
+	params := DefaultParams()
+	params.DenomFlags = []DenomFlags{
+		NewDenomFlags("dap", true, false, true),
+		NewDenomFlags("dap", false, false, false),
+	}
+	require.NotNil(t, ValidateParams(params))
+
+	params = DefaultParams()
+	params.DenomFlags = []DenomFlags{NewDenomFlags("D", true, true, true)}
+	require.NotNil(t, ValidateParams(params))
+
+	params = DefaultParams()
+	params.EmergencyAdminDid = "admin"
+	require.NotNil(t, ValidateParams(params))
+
+	params = DefaultParams()
+	params.MaxMultiSendOutputs = 0
+	require.NotNil(t, ValidateParams(params))
+
+	params = DefaultParams()
+	params.OracleHistoryLength = 0
+	require.NotNil(t, ValidateParams(params))
+}
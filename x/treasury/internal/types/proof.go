@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+// OracleProof is the proof of an oracle msg under the ed25519 proof scheme. It
+// is given in the msg as "<external-tx-id>:<base58-signature>", where the
+// signature is an attestation over the sign bytes of the msg.
+type OracleProof struct {
+	ExternalTxId string
+	Signature    []byte
+}
+
+// ParseOracleProof parses a proof, splitting it at its last colon so that the
+// external tx id may itself contain colons
+func ParseOracleProof(proof string) (OracleProof, error) {
+	i := strings.LastIndex(proof, ":")
+	if i <= 0 || i == len(proof)-1 {
+		return OracleProof{}, ErrInvalidOracleProof(
+			"proof must be of the form <external-tx-id>:<base58-signature>")
+	}
+
+	signature := base58.Decode(proof[i+1:])
+	if len(signature) == 0 {
+		return OracleProof{}, ErrInvalidOracleProof("proof signature is not base58")
+	}
+
+	return OracleProof{
+		ExternalTxId: proof[:i],
+		Signature:    signature,
+	}, nil
+}
+
+// SpentProof records that an oracle used a proof with an external tx id
+type SpentProof struct {
+	OracleDid    exported.Did `json:"oracle_did" yaml:"oracle_did"`
+	ExternalTxId string       `json:"external_tx_id" yaml:"external_tx_id"`
+}
+
+func NewSpentProof(oracleDid exported.Did, externalTxId string) SpentProof {
+	return SpentProof{
+		OracleDid:    oracleDid,
+		ExternalTxId: externalTxId,
+	}
+}
+
+// OracleProofSignBytes returns the bytes that an oracle attests to for an
+// operation. The from account is the source of a transfer and empty for a mint
+// or burn. The account is the recipient of a transfer or mint, and the account
+// that is burned from for a burn, as given in the msg.
+func OracleProofSignBytes(operation string, amount sdk.Coins, from, account, externalTxId string) []byte {
+	attestation := struct {
+		Operation    string    `json:"operation"`
+		Amount       sdk.Coins `json:"amount"`
+		From         string    `json:"from,omitempty"`
+		Account      string    `json:"account"`
+		ExternalTxId string    `json:"external_tx_id"`
+	}{operation, amount, from, account, externalTxId}
+
+	bz, err := json.Marshal(attestation)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
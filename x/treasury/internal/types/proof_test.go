@@ -0,0 +1,19 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOracleProof(t *testing.T) {
+	proof, err := ParseOracleProof("eth:0xabc:3yZe7d")
+	require.Nil(t, err)
+	require.Equal(t, "eth:0xabc", proof.ExternalTxId)
+	require.NotEmpty(t, proof.Signature)
+
+	for _, invalid := range []string{"", "proof", ":3yZe7d", "0xabc:", "0xabc:0OIl"} {
+		_, err = ParseOracleProof(invalid)
+		require.NotNil(t, err, invalid)
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/tokenchain/dp-hub/x/did/exported"
+)
+
+const (
+	// ProposalTypeBlacklist defines the type for a BlacklistProposal
+	ProposalTypeBlacklist = "Blacklist"
+)
+
+var _ govtypes.Content = BlacklistProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeBlacklist)
+	govtypes.RegisterProposalTypeCodec(BlacklistProposal{}, "treasury/BlacklistProposal")
+}
+
+// BlacklistProposal adds the address of a DID or a bech32 address to the
+// treasury blacklist, or removes it from the blacklist
+type BlacklistProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	DidOrAddr   string `json:"did_or_addr" yaml:"did_or_addr"`
+	Blacklisted bool   `json:"blacklisted" yaml:"blacklisted"`
+}
+
+func NewBlacklistProposal(title, description, didOrAddr string, blacklisted bool) BlacklistProposal {
+	return BlacklistProposal{
+		Title:       title,
+		Description: description,
+		DidOrAddr:   didOrAddr,
+		Blacklisted: blacklisted,
+	}
+}
+
+func (bp BlacklistProposal) GetTitle() string       { return bp.Title }
+func (bp BlacklistProposal) GetDescription() string { return bp.Description }
+func (bp BlacklistProposal) ProposalRoute() string  { return RouterKey }
+func (bp BlacklistProposal) ProposalType() string   { return ProposalTypeBlacklist }
+
+func (bp BlacklistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(bp); err != nil {
+		return err
+	}
+	return ValidateDidOrAddr(bp.DidOrAddr)
+}
+
+func (bp BlacklistProposal) String() string {
+	return fmt.Sprintf(`Blacklist Proposal:
+  Title:        %s
+  Description:  %s
+  Did Or Addr:  %s
+  Blacklisted:  %t
+`, bp.Title, bp.Description, bp.DidOrAddr, bp.Blacklisted)
+}
+
+// ValidateDidOrAddr checks that a string is either a DID or a bech32 address
+func ValidateDidOrAddr(didOrAddr string) error {
+	if valid, err := CheckNotEmpty(didOrAddr, "DidOrAddr"); !valid {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(didOrAddr)
+	if err != nil && !exported.IsValidDid(didOrAddr) {
+		return exported.InvalidAddress("neither a did nor an address: " + didOrAddr)
+	}
+	return nil
+}
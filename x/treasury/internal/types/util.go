@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tokenchain/dp-hub/x/did/exported"
 	"strings"
+	"time"
 )
 
 func NewMsgSend(toDidOrAddr string, amount sdk.Coins, senderDid exported.Did) MsgSend {
@@ -14,6 +15,32 @@ func NewMsgSend(toDidOrAddr string, amount sdk.Coins, senderDid exported.Did) Ms
 	}
 }
 
+func NewMsgMultiSend(outputs []MultiSendOutput, senderDid exported.Did) MsgMultiSend {
+	return MsgMultiSend{
+		FromDid: senderDid,
+		Outputs: outputs,
+	}
+}
+
+// ParseMultiSendOutputs parses outputs of the form
+// <to-did-or-address>=<amount>[;<to-did-or-address>=<amount>]
+func ParseMultiSendOutputs(outputsStr string) ([]MultiSendOutput, error) {
+	var outputs []MultiSendOutput
+	for _, outputStr := range strings.Split(strings.TrimSpace(outputsStr), ";") {
+		outputStrs := strings.Split(strings.TrimSpace(outputStr), "=")
+		if len(outputStrs) != 2 || len(outputStrs[0]) == 0 {
+			return nil, exported.UnknownRequest("invalid multi-send output: " + outputStr)
+		}
+
+		amount, err := sdk.ParseCoins(outputStrs[1])
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, MultiSendOutput{ToDidOrAddr: outputStrs[0], Amount: amount})
+	}
+	return outputs, nil
+}
+
 func NewMsgOracleTransfer(fromDid exported.Did, toDidOrAddr string, amount sdk.Coins,
 	oracleDid exported.Did, proof string) MsgOracleTransfer {
 	return MsgOracleTransfer{
@@ -44,6 +71,48 @@ func NewMsgOracleBurn(fromDid exported.Did, amount sdk.Coins,
 	}
 }
 
+func NewMsgSetOperationEnabled(operation, denom string, enabled bool, adminDid exported.Did) MsgSetOperationEnabled {
+	return MsgSetOperationEnabled{
+		AdminDid:  adminDid,
+		Operation: operation,
+		Denom:     denom,
+		Enabled:   enabled,
+	}
+}
+
+func NewMsgSetBlacklisted(didOrAddr string, blacklisted bool, oracleDid exported.Did) MsgSetBlacklisted {
+	return MsgSetBlacklisted{
+		OracleDid:   oracleDid,
+		DidOrAddr:   didOrAddr,
+		Blacklisted: blacklisted,
+	}
+}
+
+func NewMsgCreateEscrow(recipientDidOrAddr string, amount sdk.Coins, releaseDid exported.Did,
+	timeout time.Time, senderDid exported.Did) MsgCreateEscrow {
+	return MsgCreateEscrow{
+		SenderDid:          senderDid,
+		RecipientDidOrAddr: recipientDidOrAddr,
+		ReleaseDid:         releaseDid,
+		Amount:             amount,
+		Timeout:            timeout,
+	}
+}
+
+func NewMsgReleaseEscrow(escrowId uint64, releaseDid exported.Did) MsgReleaseEscrow {
+	return MsgReleaseEscrow{
+		ReleaseDid: releaseDid,
+		EscrowId:   escrowId,
+	}
+}
+
+func NewMsgRefundEscrow(escrowId uint64, senderDid exported.Did) MsgRefundEscrow {
+	return MsgRefundEscrow{
+		SenderDid: senderDid,
+		EscrowId:  escrowId,
+	}
+}
+
 func CheckNotEmpty(value string, name string) (valid bool, err error) {
 	if strings.TrimSpace(value) == "" {
 		return false, exported.UnknownRequest(name + " is empty.")
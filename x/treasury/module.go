@@ -32,18 +32,16 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 }
 
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
-	// return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
-	return nil
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	// var data GenesisState
-	// err := ModuleCdc.UnmarshalJSON(bz, &data)
-	// if err != nil {
-	// 	return err
-	// }
-	// return ValidateGenesis(data)
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
@@ -61,16 +59,39 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	treasuryTxCmd.AddCommand(flags.PostCommands(
 		cli.GetCmdSend(cdc),
+		cli.GetCmdMultiSend(cdc),
 		cli.GetCmdOracleTransfer(cdc),
 		cli.GetCmdOracleMint(cdc),
 		cli.GetCmdOracleBurn(cdc),
+		cli.GetCmdSetOperationEnabled(cdc),
+		cli.GetCmdSetBlacklisted(cdc),
+		cli.GetCmdCreateEscrow(cdc),
+		cli.GetCmdReleaseEscrow(cdc),
+		cli.GetCmdRefundEscrow(cdc),
 	)...)
 
 	return treasuryTxCmd
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	treasuryQueryCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "treasury query sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	treasuryQueryCmd.AddCommand(flags.GetCommands(
+		cli.GetParamsRequestHandler(cdc),
+		cli.GetCmdBlacklist(cdc),
+		cli.GetCmdOracleTotals(cdc),
+		cli.GetCmdOracleHistory(cdc),
+		cli.GetCmdEscrow(cdc),
+		cli.GetCmdEscrows(cdc),
+	)...)
+
+	return treasuryQueryCmd
 }
 
 type AppModule struct {
@@ -105,21 +126,19 @@ func (AppModule) QuerierRoute() string {
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	// return keeper.NewQuerier(am.keeper)
-	return nil
+	return NewQuerier(am.keeper)
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
-	// var genesisState GenesisState
-	// ModuleCdc.MustUnmarshalJSON(data, &genesisState)
-	// InitGenesis(ctx, genesisState)
+	var genesisState GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	// gs := ExportGenesis(ctx)
-	// return ModuleCdc.MustMarshalJSON(gs)
-	return nil
+	gs := ExportGenesis(ctx, am.keeper)
+	return ModuleCdc.MustMarshalJSON(gs)
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {